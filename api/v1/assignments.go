@@ -0,0 +1,18 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrInvalidAssignment = errors.New("invalid role assignment")
+
+// Validate checks that the assignment doesn't expire before it becomes
+// active.
+func (a *NewRoleAssignment) Validate() error {
+	if a.NotBefore != nil && a.ExpiresAt != nil && !a.ExpiresAt.After(*a.NotBefore) {
+		return fmt.Errorf("%w: expiresAt must be after notBefore", ErrInvalidAssignment)
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrInvalidDenyRule = errors.New("invalid deny rule")
+
+// Validate checks that the deny rule applies to either a subject or a
+// role, and that its target is valid.
+func (r *NewDenyRule) Validate() error {
+	hasSubject := r.Subject != nil && *r.Subject != ""
+
+	if hasSubject == (r.Role != nil) {
+		return fmt.Errorf("%w: exactly one of subject or role must be set", ErrInvalidDenyRule)
+	}
+
+	if r.Scope == "" {
+		return fmt.Errorf("%w: scope is required", ErrInvalidDenyRule)
+	}
+
+	if err := ValidateTarget(r.Target); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidDenyRule, err)
+	}
+
+	return nil
+}
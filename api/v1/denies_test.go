@@ -0,0 +1,64 @@
+package v1_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+)
+
+func TestNewDenyRuleValidate(t *testing.T) {
+	t.Parallel()
+
+	subject := "urn:infratographer:user:" + uuid.NewString()
+	role := apiv1.EntityID(uuid.New())
+	scope := uuid.NewString()
+
+	tests := []struct {
+		name    string
+		rule    apiv1.NewDenyRule
+		wantErr bool
+	}{
+		{
+			name: "subject",
+			rule: apiv1.NewDenyRule{Subject: &subject, Target: "compute.instances.delete", Scope: scope},
+		},
+		{
+			name: "role with wildcard",
+			rule: apiv1.NewDenyRule{Role: &role, Target: "compute.*", Scope: scope},
+		},
+		{
+			name:    "subject and role",
+			rule:    apiv1.NewDenyRule{Subject: &subject, Role: &role, Target: "compute.*", Scope: scope},
+			wantErr: true,
+		},
+		{
+			name:    "neither subject nor role",
+			rule:    apiv1.NewDenyRule{Target: "compute.*", Scope: scope},
+			wantErr: true,
+		},
+		{
+			name:    "invalid target",
+			rule:    apiv1.NewDenyRule{Subject: &subject, Target: "compute.*.delete", Scope: scope},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.rule.Validate()
+			if tc.wantErr {
+				assert.ErrorIs(t, err, apiv1.ErrInvalidDenyRule)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
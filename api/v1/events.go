@@ -0,0 +1,124 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventVersion is the version of the schema of the events LMI publishes.
+// Fields may be added to events within a version, but any other change
+// to the schema bumps it.
+const EventVersion = "v1"
+
+// EventSource identifies LMI as the source of the events it publishes.
+const EventSource = "lmi"
+
+// EventType is the kind of change an event describes. It's also the
+// suffix of the subject the event is published on.
+type EventType string
+
+const (
+	EventRoleCreated           EventType = "roles.created"
+	EventRoleUpdated           EventType = "roles.updated"
+	EventRoleDeleted           EventType = "roles.deleted"
+	EventRolePermissionAdded   EventType = "roles.permissions.added"
+	EventRolePermissionRemoved EventType = "roles.permissions.removed"
+	EventRoleIncludeAdded      EventType = "roles.includes.added"
+	EventRoleIncludeRemoved    EventType = "roles.includes.removed"
+
+	EventAssignmentCreated EventType = "assignments.created"
+	EventAssignmentUpdated EventType = "assignments.updated"
+	EventAssignmentRemoved EventType = "assignments.removed"
+
+	EventPermissionCreated EventType = "permissions.created"
+	EventPermissionUpdated EventType = "permissions.updated"
+	EventPermissionDeleted EventType = "permissions.deleted"
+
+	EventDenyRuleCreated EventType = "denies.created"
+	EventDenyRuleDeleted EventType = "denies.deleted"
+
+	EventSubjectCreated EventType = "subjects.created"
+	EventSubjectDeleted EventType = "subjects.deleted"
+
+	EventGroupCreated       EventType = "groups.created"
+	EventGroupUpdated       EventType = "groups.updated"
+	EventGroupDeleted       EventType = "groups.deleted"
+	EventGroupMemberAdded   EventType = "groups.members.added"
+	EventGroupMemberRemoved EventType = "groups.members.removed"
+
+	// EventDirectoryMoved is published when a directory moves under a
+	// new parent, summarizing who gained or lost access in its subtree.
+	EventDirectoryMoved EventType = "directories.moved"
+
+	// EventEffectivePermissionsChanged is published for every subject
+	// whose effective permissions changed because of another change.
+	EventEffectivePermissionsChanged EventType = "effective_permissions.changed"
+
+	// EventEffectivePermissionsLapsed is published for every subject
+	// whose effective permissions lapsed because role assignments expired.
+	EventEffectivePermissionsLapsed EventType = "effective_permissions.lapsed"
+)
+
+// Event describes a change made to LMI. Besides the envelope, only the
+// details relevant to the event type are set, e.g. Role and Permission
+// for EventRolePermissionAdded.
+type Event struct {
+	Version string    `json:"version"`
+	ID      string    `json:"id"`
+	Type    EventType `json:"type"`
+	Time    time.Time `json:"time"`
+	Source  string    `json:"source"`
+
+	EventDetails
+}
+
+// EventDetails describes the objects an event is about.
+type EventDetails struct {
+	Role         *RoleInfo                   `json:"role,omitempty"`
+	IncludedRole *RoleIdentifier             `json:"includedRole,omitempty"`
+	Permission   *Permission                 `json:"permission,omitempty"`
+	Assignment   *Assignment                 `json:"assignment,omitempty"`
+	DenyRule     *DenyRule                   `json:"denyRule,omitempty"`
+	Subject      *Subject                    `json:"subject,omitempty"`
+	Group        *Group                      `json:"group,omitempty"`
+	Member       *Subject                    `json:"member,omitempty"`
+	Changes      *EffectivePermissionChanges `json:"changes,omitempty"`
+	Move         *DirectoryMove              `json:"move,omitempty"`
+}
+
+// EffectivePermissionChanges lists the effective permissions a subject
+// was granted or lost.
+type EffectivePermissionChanges struct {
+	Subject string                `json:"subject"`
+	Granted []EffectivePermission `json:"granted"`
+	Revoked []EffectivePermission `json:"revoked"`
+}
+
+// DirectoryMove describes a directory moving under a new parent, and the
+// subjects who gained or lost effective permissions in its subtree.
+type DirectoryMove struct {
+	Directory string   `json:"directory"`
+	Parent    *string  `json:"parent,omitempty"`
+	Gained    []string `json:"gained,omitempty"`
+	Lost      []string `json:"lost,omitempty"`
+}
+
+// EffectivePermission is a target a subject is allowed on a scope, and
+// the role that grants it.
+type EffectivePermission struct {
+	Target string `json:"target"`
+	Scope  string `json:"scope"`
+	Role   string `json:"role"`
+}
+
+// NewEvent returns an event of the given type with its envelope filled in.
+func NewEvent(t EventType) *Event {
+	return &Event{
+		Version: EventVersion,
+		ID:      uuid.NewString(),
+		Type:    t,
+		Time:    time.Now().UTC(),
+		Source:  EventSource,
+	}
+}
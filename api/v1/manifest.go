@@ -0,0 +1,89 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/invopop/yaml"
+)
+
+var (
+	ErrInvalidServiceName = errors.New("invalid service name")
+
+	ErrInvalidManifest = errors.New("invalid service permission manifest")
+)
+
+var serviceNameRegexp = regexp.MustCompile(`^[a-z][a-z0-9-]*$`)
+
+// ParseServicePermissionManifest parses a service permission manifest.
+// Since JSON is a subset of YAML, the manifest may be written in either.
+//
+// For example:
+//
+//	permissions:
+//	  - target: instances.create
+//	    description: Create instances
+func ParseServicePermissionManifest(data []byte) (*ServicePermissionManifest, error) {
+	m := &ServicePermissionManifest{}
+
+	if err := yaml.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidManifest, err)
+	}
+
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Validate checks that every permission in the manifest has a valid,
+// non-wildcard target and that no target is declared twice.
+func (m *ServicePermissionManifest) Validate() error {
+	seen := make(map[string]struct{}, len(m.Permissions))
+
+	for _, p := range m.Permissions {
+		if p.Target == "" {
+			return fmt.Errorf("%w: permission without a target", ErrInvalidManifest)
+		}
+
+		if err := ValidateTarget(p.Target); err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidManifest, err)
+		}
+
+		if IsWildcardTarget(p.Target) {
+			return fmt.Errorf("%w: target %s is a wildcard", ErrInvalidManifest, p.Target)
+		}
+
+		if _, ok := seen[p.Target]; ok {
+			return fmt.Errorf("%w: target %s declared more than once", ErrInvalidManifest, p.Target)
+		}
+
+		seen[p.Target] = struct{}{}
+	}
+
+	return nil
+}
+
+// ValidateServiceName checks that a service name can be used as a
+// permission namespace.
+func ValidateServiceName(name string) error {
+	if !serviceNameRegexp.MatchString(name) {
+		return fmt.Errorf("%w: %q", ErrInvalidServiceName, name)
+	}
+
+	return nil
+}
+
+// ServiceTarget returns the target of a service's permission,
+// namespaced by the service name.
+func ServiceTarget(service, target string) string {
+	return service + "." + target
+}
+
+// ServiceNamespace returns the prefix shared by all the targets of
+// a service.
+func ServiceNamespace(service string) string {
+	return ServiceTarget(service, "")
+}
@@ -0,0 +1,111 @@
+package v1_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+)
+
+func TestParseServicePermissionManifest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		data    string
+		targets []string
+		wantErr bool
+	}{
+		{
+			name: "yaml",
+			data: `
+permissions:
+  - target: instances.create
+    description: Create instances
+  - target: instances.delete
+`,
+			targets: []string{"instances.create", "instances.delete"},
+		},
+		{
+			name:    "json",
+			data:    `{"permissions": [{"target": "instances.create", "description": "Create instances"}]}`,
+			targets: []string{"instances.create"},
+		},
+		{
+			name: "missing target",
+			data: `
+permissions:
+  - description: Create instances
+`,
+			wantErr: true,
+		},
+		{
+			name: "wildcard target",
+			data: `
+permissions:
+  - target: instances.*
+`,
+			wantErr: true,
+		},
+		{
+			name: "duplicate target",
+			data: `
+permissions:
+  - target: instances.create
+  - target: instances.create
+`,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			m, err := apiv1.ParseServicePermissionManifest([]byte(tc.data))
+			if tc.wantErr {
+				assert.ErrorIs(t, err, apiv1.ErrInvalidManifest)
+				return
+			}
+
+			require.NoError(t, err)
+
+			targets := make([]string, len(m.Permissions))
+			for i, p := range m.Permissions {
+				targets[i] = p.Target
+			}
+
+			assert.Equal(t, tc.targets, targets)
+		})
+	}
+}
+
+func TestValidateServiceName(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, apiv1.ValidateServiceName("compute"))
+	assert.NoError(t, apiv1.ValidateServiceName("load-balancer2"))
+	assert.ErrorIs(t, apiv1.ValidateServiceName("Compute"), apiv1.ErrInvalidServiceName)
+	assert.ErrorIs(t, apiv1.ValidateServiceName("compute_%"), apiv1.ErrInvalidServiceName)
+	assert.ErrorIs(t, apiv1.ValidateServiceName(""), apiv1.ErrInvalidServiceName)
+}
+
+func TestSelfPermissionManifest(t *testing.T) {
+	t.Parallel()
+
+	m := apiv1.SelfPermissionManifest()
+	require.NoError(t, m.Validate())
+
+	assert.Contains(t, m.Permissions, apiv1.Permission{
+		Target:      "roles.create",
+		Description: m.Permissions[0].Description,
+	})
+
+	for _, p := range m.Permissions {
+		assert.NotContains(t, p.Target, apiv1.SelfService+".", "targets are relative to the service")
+	}
+}
@@ -18,28 +18,112 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+RZTW/jNhP+K3zn7aEFFMmb7Uk3dxMUBrbbwG1P2xwYcWxzYZFakkrWCPTfC1K0JVmS",
-	"LW3SVEFOsSRyvud5yMkjJDLNpEBhNMSPoJMNptT9nGvN1yJFYewT3W5/X0H8+RF+ULiCGP4fVTsjvy36",
-	"hA9LucXa1iJ4hEzJDJXh6OQquUX71+wyhBi0UVysIYBvF2t54V9eC8PNbnEFRRGAwq85V8gg/lxuvg32",
-	"m+XdF0wMFLdFANdKSWUFN9Ulkjl1K6lSaiAGLsz7SziI4MLgGhUUAaSoNV13GHdshZNZrW/bE4CPRNse",
-	"hjpRPDNcig5FAQiaDrDArTqht5m8pgU6kRl26tZ5Kees+v3CwAvrsuQGVcq19n4Oq59qz4KhMHzFXWbG",
-	"xbDoLI9O0a3YGKrWOCAAfl2X3/u0D/PYrl6IlezwMjtY7B65wdT9GBZAqMJAlaK7lguc9TTSwaR2Lymk",
-	"BtncNBqKUYMXhqdYNVVVUufKnbPhWNDbHAHkGRtnWTsYXnpQ87Iutx0rK4P7OCVSGFp2TmkjLMRKUSPX",
-	"imYbVGSem41U2opUW4hhY0wWR9Gam01+FyYyjXhjQyt28PG3BeGaUEHmNwtiJEmpoGskNElQa/tCo7rn",
-	"CeoQAtjyBIXGmkHzjCYbJJfhrGGEjqPo4eEhpO5zKNU68nt19HHx4frTH9cXl+Es3Jh062qKG1vfzpwL",
-	"8hENSZFw8T8I4B5V2e4wC2fhO7taZihoxiGG9+E7pzmjZuOKKaIHjHLPvu+aTis0uRKaULLl2hC5IpYB",
-	"NCn3IrNuU1LBka1WalyTQwy/opnXlFjliqZoUGnXmE1dXooVWaolNQvJSiqw+YYYvuaodvt6iWtgWFWU",
-	"UTkGnk070aSl3OLoeNUOfZ+k2MZztF5Hw/1qesn81pqqM2mry267nM323bM/Z2TZlicuhdEXXYJGpWYQ",
-	"BjZOH8cY2Gor53+9FN2KFc23ZpRppywqjyYdynOB3zJMDDKC+zVFANER9A9sjfqujla4aXw+2QolvdXK",
-	"ohJNLORZfLW/+yvEE+SpUnyRYjhJiK181CM4hTpwYNdbActucAz/Fl3pXzpZLxHz2plmUPtpsrfpPw96",
-	"AJnUHaH+4M4ENtQCH4iHv2aIyyXL8pMFZNTmF8l2z+bI/krR4crSgzhlLUZsUkPxxPyfS3svwk4mw4e2",
-	"ih45K8pMb9FgO+fle5tzzcXa+mAduaMaGZGCmA2SxRXRubUbWasertx2Xw8n0XZxte9dmzxvjodVe1yq",
-	"UNUdU4eS/QgW/rmDXqw5pS1sAm15BgCbqTlkbHEV9kHhuKSUNPiySXlrnRlAlnck+S93BWsn+Vz/lfvG",
-	"p7q88v3rqX5+fjhHDgfHJkIJ/m49MUY4vpb2sYPCVN6X7ODvjSslU1+lrWJcutVHk7mRCGQl1G4qTt8r",
-	"LNOOGXE7T39u8BBXKhipXY9dGGzzu8j4IAwq6ZMXPy95slzXvu75+BwPQzrrz5PeiGlIF//1TQemyIdv",
-	"YVTQd1sp3TpQ5skZWbl2PE2WcXhb+PMj4woTI9XupyoEFRYZ+WQkmh4dHo2i+uhweaDD2qhoGCPWRjTf",
-	"w4hH+l5hRXb/16udrWpdzf1mjL+3+mpBnDgPLk+NPS0pnaHAEVPQTgo80vbqKbDnX67DyHBK89JeMmTs",
-	"CJb6j0lzxp6CSJSxpp43A0aHwePz4RBlbApsWBT/BAAA//+qF/BFJCMAAA==",
+	"H4sIAAAAAAAC/+x9a5PbuJX2X8HLd6ucpNhqjzPZqvS3ztg721k747KdndoauSYQeSQhTQEMALascvV/",
+	"38LBhSAJ6tY3ebY/dUsigYODc8cD4GtWiFUtOHCtsouv2RJoCRL/ffOJLszfElQhWa2Z4NlFdgNSMcGJ",
+	"mBO9BCJFBTnRgtRUKUIVuZqfvaO6WGZ5poolrKhpQm9qyC4ypSXji+z29jbPairpCrTrq2ikEnLYm/3e",
+	"d1bTBZi+JOhG8pzMpVgRSmoJN0w0ikhQteAKJuRKv1BE8Goz5Te0YiVZM73ENhRdAVFCakJ5SYQsQRqy",
+	"9ZLq9v0pz/KMGQL+1YDcZHnG6QoCPVvHlmeshFUtNPBi81+wGQ7q75z9qwFyDZvARfhXA0rnZEWvGV8Q",
+	"pomicz9WuZmQS//MlK9oCYQuKOO9UV3DJicwWUwInWszLKLZCkSjc8IUf6EJreuKQTnl9u1Zox0rFTYi",
+	"JFswTqvAhzx0MOVXflD67APUFd1ASaywEAU6Jw3XrMJmzLjgS80kqAn5ZL+Y8oIaCmZmrI2CksyFJJQL",
+	"vQTZDt9MyZyyStmOv3/5Z7JesgqmvEcfPk+YIkqzqiIzMFyrpShAKSij+bMkthN41c7NmZmceCZX9Mtb",
+	"4Au9zC5e/elPeWpm51a2B1NqdMVPZkJD8J9iSfkCDNEzajggOLJnytsfjMQSnF8WvRyGSbWVU9cD8mvK",
+	"Y4Z994ogS9dMwTYu7KOkeVaxFdPDwa7oF7ZqVoQ3qxmgckpQTaVVq5sj6mMbjDstYU6bSmcX3718mfuW",
+	"8ZP5yLj7GCaDcQ0LkEgeKu+QPPzakIJa7kljYzTZVmKa/k3CPLvI/v95axrP7a/q/KOQ+id8A62Y+9q8",
+	"dakUW/AVcOQYraqf5tnFL9tb+xusP4gKoldv869ZLUUNUjPAdo0IDKcnz76cLcSZ+/IN10xvrl4jW4x2",
+	"MAlldvGLfflz4J6Y/RMKnd1+vs0jen9A+duf6q3kino4Iz8vAfVcC0LxXSIkabj738v5hHCh/wJzIcEK",
+	"tjMil5pQCVY30HS0zTBthRy4kZJfMvt1lme+8WjskVzHHBL1nvz5gIKUXfQHDFJaz7WNZW/wISMwmupG",
+	"DVl05o3zRWwr1lShOZjyM7SLvZ8L0VSlM6vmsZwoAIIEmTekqCoof53R4nr4WvuOcQNrig5CMV7AlJNg",
+	"md0bzpRRLVasIDNjOhw9XfbbIWR5Zn/M8iyiYfdcOOYM5yOejbdMHaBh7+kCElLKNKy6/+wt8J42KiXd",
+	"DIZg2xuRqKZk+g3XcjOUIlpYQejLxdrYeyMGpeCQE2OzSufezYzADXBN6mZWMbV0XhUtbI/RuekgFWCp",
+	"Bgkk66WwjqeVk9zoI1GgsVX7nQuU1iCBv/CxiOBkBktazY2cUHLNxZoT1/CUJ4kxA8BhlyUzlNDqfcQO",
+	"LRvIe4QauiwzlY3XYINmoeVFiuoSKjANqCwhUzO0NXcnw3CD2MbG2SeBjhLCyoT/zd0DCWNx9Vp5lfS0",
+	"MH4jqhsoCeMRDVneCvig+a4c55kJFs2DcyFXVGcXWUk1nOG3uxSXGVV3TzpJbslPqrNRhbdikTCnXEsG",
+	"B+hmq1SJMXH4on/YK7cwT2KC0c6bsKysqLK/7GSDpz014r8Yo9kakg82hE0YArSxndBoTis1EMR15FPr",
+	"utoQLry1pprQqjIhJOUbG0+3pM+EqIByQ5J9/Bgz6CKGW4zYruy7IWTzn3dYSt/7XsxKu17vby6+jjEn",
+	"aIIzXCVDf2l4YtXWGLAkc1zceDRzHM27/EXrMn2Ho/z4YQnF9ajcFObX/cntNNafx50zh13tIjQ9Z4fy",
+	"NW5sFzO3sXA791QhakhaSefHkr9pKheg07lTTJdvI7yRuw63EDoi8VUl1hC7i0hkS+CbXex8DXzzoalQ",
+	"d31m0XcuvcyVarKQlGsobREG5IopZW38UUmJH0Rq9IG+Q3KoaFADtZBANZSXel/PlvbGe44M/WDbZToM",
+	"fOMThh6lokzMxn9++vSe2LiYmCfC3LgSTZa3o2Jc//FVNkyV82wFStFFovlls6L8TAIt6awCEv3oO7Lp",
+	"TYJNEqhKRazvaLFkHNpG7YOd9i5MesJsae5XKheNsZsXcSnMFD1WtDJjM0nGmUkXG70ErllhuNt/GMtb",
+	"8QOYMgk5Y2UJ3D7tY133tJVC40BX9Bri9sy7XOhf56Lh5QWh3IVZfQLpTDSalAKwQfjC7KuF4POKFb0B",
+	"+W9VW7UrGimBa5xfsKU7m3z5/rr1PRP8E1oZxm5sb8p0V0soBLcB7K8+S6Sdr3t1Rk+y6WwpqtLECSsh",
+	"IZ4VCXOQwAvojgK/xkKPjzytXxWuOSTLpPeh3mdSdl+W5KZMKGlxDWWbIJg+NUhOqz7D2uw2lPY6+WZf",
+	"gmziH0uBVQ8rBFmehUk1aurmI8uzBAezPLQeOIHfWUp357KozkFLWhVMGb0fpWjqgyyefeMezV06K2x9",
+	"wQI7TLze1OVhPW43mXGDafMZ2/thHfYLLXS1IYKjpfTjENI6s1WjUJwUaCypC7R5fENkY4rMNhhD6YYb",
+	"kJvwvlESU2EOXtGlBujFbQmqH+V44zi0mwfV8/I7hya9/BW/NyM0485Neb1YkhXdGLZQsmZVWVBZ7py2",
+	"PQKZIKYDV9chKUG8rczuiqrwqZGOPzgmH9ZvySQUWsjEmo1Zxwg/W/bNGYeoSG+r+fZDQbmtV86MVGFm",
+	"0KbmoZkXygiYlgAT8hboDRBY1TpqfcopWVRiRitbIE0XU+6HW93ydZdvoRCbLO3aUdHwfrv4c6UJN4qE",
+	"/sbkoy6ztgPZzzyFmvB+fc+gECtQxFQhbvxCoP1AJFssNaFrupny44g5RhdHc4FxzXnvYrXuLDx8LWNI",
+	"SBvr719xDe9clcA1mzOMQQ/Txduk6U82PeDTvlmZe+7z1nE/QbW57fxO1WZvAvej3Dx9xeciQX2b8D3M",
+	"GMotA9g2z3fL01LTHphwcQ9x1f05m2gd2PqFEr2NodYmEyYLoVPevkIlOMcx4jMO4d2ohzki+Ms9fmQ4",
+	"6P9OAUsYLyQYww4lWS/BepM2CpOEmRwoklBX7vOGv1J+Rdosm6jw6gtFzJJ9z/Yzrv/9exu0lz/xauOX",
+	"ABJrz4MQFnm0fyRrhe0JrEuk6HezLX/H0aWFN9RcfW4tCHVrvF3Zpn3JnnLG29zRtlOmIuwHjiE/grxh",
+	"BbR27B3lbJ6sHT6wfYyb34tS9ZrN50MyaVmmSuXWC0brekTCgikN0ibBey8fSdDUzOCOLoxp4oJUgi9M",
+	"YAhFRSWUdhHa9H4NNUZxtFEw5RJtnEW/NArD7dWUH0oXk8eSZQBJjikrcXMgR5z+j/e8XgrVLbqZlV7/",
+	"2v5d9eurONNt/y0PollKilIAt8TLTxlVRQdjYT4ZohNFkDz72AbCu931nn7ZtfnaLeamMgFqzL2bJELN",
+	"NNriGfU5/ISEdKGtAxqviZWN3BY4zFeh2mEXj8FhBz3UpKdUIfMYGiXM9KPcpKWvLeuFZODOOIQ8c+Ru",
+	"Xw0L6/0GUmBfyAkuMNr1cmlqITy9Xli6sktiqKGIcvdBxisU/SHCfA6Yy73v2twuMS0uLTxP4hBhC4WD",
+	"UGBYS1/4msa2Qfj6XLYCQ0uCSPdDIAaxrEH2clcKaHgFSg2kNpTj9uKoV8kEQx0VS1YnSMROBp3HBO9L",
+	"QWBHv/8j0uZY5WKZ7A4mfMpGpKbVl6HFMYRB0UimNx/NCKzEz4BKkJeNXraf/sPLy19//pT1l+gvyV9/",
+	"/kRc8QeFzZUlsfYv+JwtGuNkrmGjclS/7g9TzpRqQKIXok3JgBcuggrz0V31UGjA6DVwnJ4p7zZIioqy",
+	"lbVjODWo4DiQVtSXWtcW3MhcKlQIrqmdJI8f5XNJtVhIWhvDYngikNWNrFwTF+fnC6aXzWxSiNU567ww",
+	"SI+yt++uULA5uXx/ZcNGbsDetChQ/gVRNsxRkyzPKlYAVxARdFnTYgnk1eRlhwh1cX6+Xq8nFH+eCLk4",
+	"d++q87dXP7z528c3Z68mLydLvaosBEZX4Mg5I29BkxUQxv9fFuUt2cvJy8l35mlRA6c1yy6yP06+w55r",
+	"qpcoK+c9x5CsxXrsNSUVLprMiQ13QsEQw+ZW8kUNEkFEV2V2kf0I+rKjCjGi/pexin5A6HYc01zIEXRs",
+	"23urjTYp2gIcHnReiBoO79q8dbeO7Qr2gf2al7YAo0dLDJ/zzK/K4py/evnSa48HBZv1hQKn8Pyfbn2g",
+	"7ebuUMSBWvUjEKt4Lq47gLQ9oK3DzhsD4IXCRFDgn7nNO6pxPvNw+lqohIbY0So0gB7Sq5yWMG6Ug/GF",
+	"mWJJubLAswl5Q4ulyxyNBfXAWpP+v//p4yds7PWbt28+vSHn2NT5V1bexmSRtVl6dPB6hoGbgWNY7+eK",
+	"CYy3C7PRLpKQ+06IxQEpY4SpgxxRCURds7o2UVfk25ENaAAR/YXroLxFdHWGEcO6rCnv2gXb7VbTkJrO",
+	"9pHz3v4VK9i4JvsXUW7uTXBGEHEJSfrUrfFH1QXT+2ZgI27vqIkHUW2RSQndw18CUNMRfRoaaDCTo27p",
+	"Q7QlCJ8klVj0huGwwoK8fXeVEw5rUJrMmVQmS3pjYZC4CjYDMmcVVhPIzNYyEYmcBhxTPuUO+hADWpnO",
+	"/ZsmScak3CgI0wEhPyF2JtSUGyWrqdkphDgR3JimEVfgVNctlmhBcCk0XjBJKZRxtB5kymBvT5sYoBrx",
+	"OMiR7CDPZlfmW6RIGv+rcqKaYmlMk6u0Xb3Ozapiu8CfGyuK/llIgxoJITKxVYrRrXCiXcfam2o3gV1Z",
+	"cuAQNMWTqPiRZpTFnR3Qp/P9HcGlCAnw0HETM9tqdapP3BPR6XI/lMM+dATQ+HYScG/dUSTssPR2P9Ye",
+	"D1qVye4c4+xEchtMeMKStXbId38ShhSRsOPhC0I5FfH1F9rJ4Vv4WQ3SzKfRZqcgv7O69/spFzzo5+9C",
+	"2fz3ab8PxfX7GJx5kl6/CzxO+3rHptyZIDT4IYewPH9Ml9+BIA8pRoJciHg6UrkrtHay2eaeW5je1L2A",
+	"e8qdaAS3i6HtPsGx6XUP8T3tqHUfIbZDjfT78cPUAwT3NALTErgrrG+NTFuhbevOORG13cJVbTohp5dr",
+	"u5hk/H6E2htEeq+jeuKBBZWWlr3qKceXMfbq6Ngqxs7izX7DdLWb8UE+SrVkfC1hKJOdJYwTiTHyMeMt",
+	"Aau9tJ0NRNvNKmGNejDmQnahrFPusazUQSzcIRrO6sfAVnQBzK5TGpvvwIq5aZFjHQKbsLtDVFgh69l1",
+	"JDVMxIla9c4mkuHcvPZcxgAER/So1nwbbUECTis0tqYcS2tWfitIoUVwTbcrybkZiBbSA67jpTvmZBzK",
+	"yUDSbFPjkpZKoMt4Yh2NzqKZWn5r0Fh5QCV6m3E9Rt47UvX9ltVXv6R7AoZrhwNvSfaHobTV3KvXk22u",
+	"+YiZ7R5K8hAz+/lZ+2Ptt0vHBwRyPpxyLyam/0f/y8OHDSPL1cPhW2q/pXihw2e7ltwiX9zOLNqJIJTw",
+	"MCxXz42XJ5nNE+0OdC1w37mYY9Dg1t9zj0s1u3JMtU2KZrEkHJSh3DJwPG740eEcTjVocJIynB384Ymi",
+	"hVGi7EzH0vr9yz8/vKQ6uMru3YwnZLoOCFw6KhVjz3DlsLcCa8J0NeUe/VMJBYMgBw8VWdIyKEuABaUU",
+	"xRIyoihjW7sXXjzvLeZ5sOCmg8U7/cCmIw2jfuyImbq3GOZhg5UDjM9Tu8omMZEW1W6LldZC8bK/LX7X",
+	"JNs2jp1nuwL2lBr5RJ4yDPxkPKVfjHx2lDsc5XkH5pp2mB8QN4q5H8aDLkD09aftKmVfxul6h68dZUBN",
+	"G/hd1PVvS9EC1Hc4jR/jgrXhxL6alsIth/0QJ+6P2/3WPh/Zx4B7L/0uQIiPc9ahz6hQ/qSO+25w8TEz",
+	"6ZX/VLPfy7LsZLTdMw/cSZcOdh9DbkPMfWn/c/vSzMAoopIUVPOcxOktF8ki+GVZ3s120bKMDZcW/xfN",
+	"Fi3LO9ksuy/KOPNXrx7BmZfhyBDXP6JKXUGAUFJsiuo0qnW9HYx7gtWjt7owgAT4bsrt+0eh77aiAsaO",
+	"N3EWuCWSMG5RU+b/8ZXL6GCTQyFm/Tzebu9zJClNZXv2E1OkljBnX0aoCD8eQMWcQVWGc6/7xIgxHJ95",
+	"On0kd8sLv/cvfLFrm/MBJsaev/2tgdV6J0QklC1WqlP1jB/cVl+j1hzW3bP9UvXY08eXRRQmmNP+am2E",
+	"Hf6j5pzbCYzs1aPXaaO+TzDdjPTp/Ks1RHsWaNs3J+R9p87qNn6H/eUGMBStdthDCGwH5XjtdZtSJN2T",
+	"v86kI4zbKrHB7j55NTaiOSrJPrZ4MtWdMwse/xZqioNS4haTa9+7H+naVlV8POl6WnP/BAXGvY19VGp8",
+	"cktrlWn/tXu7c8Oer+DB6kyRBbsBnttz8Py5O343XH8pWeBmnhhDfBGffGd3ANo8Aj+25yBFcDUhzWez",
+	"Oc4tQVNegNJCqofdHYQHMe1Szs7hgVHCYFlgD8oVdwVVDnICjgjs9kSl3Vf04J9jsx/bhc17sOunyHos",
+	"EcfkO27sPtvZ80in33yuE06qGtlh/E0BYEya47DRqQTngzhpkCqSl+DFB4cQxzph99SAx3N2Y8QZdscH",
+	"lifuGEw16x47x2dubx8rzHSg5sQaHFMhL+r5n2DcT8d/7wSwlC2AxcIupYjRmGZYV6+JasIFFanUJ60u",
+	"qXK238NwEiBbd43fQyVJ7lzpNj367hHqzeFURLMl2h2cZy/06txKiFGZkdtwB+DJQ2q6ghnDhMOJyy9U",
+	"GCBTUbGZKgwEjQnBtZ0ZYMRnf/EcsLsKDcNC/GA2XlYbs8eBhcvRPE+dzq+Acs1W24LCwzTj2wYpP7jx",
+	"P+XMnh5oPO17h4vIvWGDntB43n9cFJ1GuiU0eoLsf6tOtCcd/MbjoWfvt3/Adj44UDIdvMkApvJYBQej",
+	"SqY2Fj3VO+X/QN9kWogP4bkn7NR9b5V6sKwr4tz2Uwu6pxVEoDPkpWPbESCGHpL81BFYcmyLT/98O3ss",
+	"dIQfmPIHqM8dcFReKiobOzrugSR/11bz7qUXBtN2r9vNxzqJjwL1Zb6r149Q5OvvQR+Qxu+vZDosKO7X",
+	"03hZsZ0EX1nsXwKSvjTuN19Y7F3q/C0cYJjvOqkwGI7o5Gn7Y+cCKbqgDBelcFEDYyqv5iI6PMUKfuPS",
+	"jHXiwhuT7rrLblLAQ3zw8EQjXKT+7N+3+PfoRKaWae0ca3FnTw/lqQWojBdVU8J+UH9O3ONlG/3sDlKv",
+	"7DtHRaiG+cM+nzPmxCUg0e1M41mz0qJ2DLVcOEKeu/PR3qRwfN77nFHebUuEW0T3wPUwQbPNmHa6MPrK",
+	"a/8xMXRHDh48jH6UvRJbbtMZzFV3+CcbyfgpDnfz8LBHQuKBQUpY5FxQEHf+D0YyHcjxPGGOtRDh6k0L",
+	"sXO/T/lhGyqO9hSuv3Z8z97hWO/AAv+PDXO8cPym3cGj7DcJTrpVzJPdcBLFk3X/Jpddu0fbF/YMJ/cH",
+	"UCYjyl5/z8Zin+s+dyPvkbfdCTzWhnQw88/B5dMu1id2h+HVNolCb4TDnPL7KvQesFksGaT26H7UQu9l",
+	"e13lYP8WBlnQPRPGITo9LNY7UzUSQqXKlvClpngd/oD6cPHX8363ZwzoHvvdeqb45LeDd/dHxNeTOgk2",
+	"G8SBl/4eQX81/pQrWKyA6/ZuBUNpo2HCuNKIOZ/8wSie//oPCD1CBbaHsU6566LhJUi83qIbvQkOKrqH",
+	"k1RUgySCb0mFjo1yDGK0w4rnAOc+ApwAxL2/2MZf6/kc2TxYXuIvmTv/asR+kJzUzdY9tH0X1F5ua8pq",
+	"ru0J+eRuf7VYmSlfuZuF3faXiuKFlXZpyL+V22MWca+iME0a+lRNC3NLIFXkH9Pm5cs/FuZb/A8m9gt3",
+	"hwt+9Y/u7kfG4w5eqLZJt0dn7EpcpBLvkw13WPWu6TUNwCplrDyzhpcW77Ja8U4WR3OwkMZsMq063idp",
+	"xfDPt3lCxuh91EZw43a/nG3oqnqIlu+v3eT6Wu8IxDDLwOdCFqBy42r/+vGnvxnf+j+X794+KpZv5Jrt",
+	"5LUK9oYff2FbV5NfqJMKktDqOUTHAVv+OkAQf27sbGOuA0slZh99Dyd2flEYwslvYPpo0ylktT2939FO",
+	"lCBbjucd2eb0MQBQTnKn037nASEzHtcIjNPlp+PRT23o3Cll0bhOIU/KuOzcj/RRizol4Lkt6nkfP7hU",
+	"HS/pTF70PSE/m9StlJsPDc8JF3qJrYSbz6NdxW7WSC3hhsHaFDOodgX0WVi0Hj8DYlShxo72ikB993sG",
+	"b7dD3MbiBpUe00iZxHJtZ2Hoztun7l05cT5GNtxbBlBFfidkYMTvxy+EP/lqq3e9Wy6JvotkfiNnDh9o",
+	"lp/WJkaXy+M0xNfK//LZ8MlEin6S7F3m57Rm5zffZbefb/93ADsTP+WxqwAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
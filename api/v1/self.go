@@ -0,0 +1,78 @@
+package v1
+
+import "strings"
+
+// SelfService is the service LMI registers its own permissions under.
+// They protect its management API.
+const SelfService = "lmi"
+
+// Targets of LMI's own permissions. Permissions on roles, role assignments
+// and deny rules are checked on the scope they're defined in, or the base
+// directory for global roles; the other ones on the base directory.
+const (
+	TargetRolesCreate = "lmi.roles.create"
+	TargetRolesUpdate = "lmi.roles.update"
+	TargetRolesDelete = "lmi.roles.delete"
+
+	TargetAssignmentsCreate = "lmi.assignments.create"
+	TargetAssignmentsDelete = "lmi.assignments.delete"
+
+	TargetPermissionsCreate   = "lmi.permissions.create"
+	TargetPermissionsUpdate   = "lmi.permissions.update"
+	TargetPermissionsDelete   = "lmi.permissions.delete"
+	TargetPermissionsRegister = "lmi.permissions.register"
+
+	TargetDeniesCreate = "lmi.denies.create"
+	TargetDeniesDelete = "lmi.denies.delete"
+
+	TargetSubjectsCreate = "lmi.subjects.create"
+	TargetSubjectsDelete = "lmi.subjects.delete"
+
+	TargetGroupsCreate = "lmi.groups.create"
+	TargetGroupsUpdate = "lmi.groups.update"
+	TargetGroupsDelete = "lmi.groups.delete"
+
+	TargetAuditGet = "lmi.audit.get"
+)
+
+// SelfPermissionManifest returns the manifest LMI registers its own
+// permissions with.
+func SelfPermissionManifest() ServicePermissionManifest {
+	perms := []struct {
+		target      string
+		description string
+	}{
+		{TargetRolesCreate, "Create roles"},
+		{TargetRolesUpdate, "Update roles, their permissions and the roles they include"},
+		{TargetRolesDelete, "Delete roles"},
+		{TargetAssignmentsCreate, "Assign roles"},
+		{TargetAssignmentsDelete, "Remove role assignments"},
+		{TargetPermissionsCreate, "Create permissions"},
+		{TargetPermissionsUpdate, "Update permissions"},
+		{TargetPermissionsDelete, "Delete permissions"},
+		{TargetPermissionsRegister, "Register the permissions of services"},
+		{TargetDeniesCreate, "Create deny rules"},
+		{TargetDeniesDelete, "Delete deny rules"},
+		{TargetSubjectsCreate, "Track subjects"},
+		{TargetSubjectsDelete, "Delete subjects"},
+		{TargetGroupsCreate, "Create groups"},
+		{TargetGroupsUpdate, "Update groups and their members"},
+		{TargetGroupsDelete, "Delete groups"},
+		{TargetAuditGet, "Read the audit log"},
+	}
+
+	m := ServicePermissionManifest{
+		Permissions: make([]Permission, len(perms)),
+	}
+
+	for i, p := range perms {
+		description := p.description
+
+		m.Permissions[i] = Permission{
+			Target:      strings.TrimPrefix(p.target, ServiceNamespace(SelfService)),
+			Description: &description,
+		}
+	}
+
+	return m
+}
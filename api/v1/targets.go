@@ -0,0 +1,54 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// TargetWildcard matches any target, or any target under a prefix
+// when it's the last segment of a target, e.g. compute.instances.*.
+const TargetWildcard = "*"
+
+const targetSeparator = "."
+
+var ErrInvalidTarget = errors.New("invalid permission target")
+
+// ValidateTarget checks that a target is made of non-empty segments,
+// and that a wildcard is only ever used as a whole last segment.
+func ValidateTarget(target string) error {
+	segments := strings.Split(target, targetSeparator)
+
+	for i, s := range segments {
+		switch {
+		case s == "":
+			return fmt.Errorf("%w: %q has an empty segment", ErrInvalidTarget, target)
+		case s == TargetWildcard && i != len(segments)-1:
+			return fmt.Errorf("%w: %q has a wildcard before its last segment", ErrInvalidTarget, target)
+		case s != TargetWildcard && strings.Contains(s, TargetWildcard):
+			return fmt.Errorf("%w: %q has a partial wildcard segment", ErrInvalidTarget, target)
+		}
+	}
+
+	return nil
+}
+
+// IsWildcardTarget returns whether the target matches other targets.
+func IsWildcardTarget(target string) bool {
+	return target == TargetWildcard || strings.HasSuffix(target, targetSeparator+TargetWildcard)
+}
+
+// TargetWildcards returns the wildcard targets matching a target, from
+// the most to the least specific. For compute.instances.create these
+// are compute.instances.*, compute.* and *.
+func TargetWildcards(target string) []string {
+	segments := strings.Split(target, targetSeparator)
+	wildcards := make([]string, 0, len(segments))
+
+	for i := len(segments) - 1; i > 0; i-- {
+		prefix := strings.Join(segments[:i], targetSeparator)
+		wildcards = append(wildcards, prefix+targetSeparator+TargetWildcard)
+	}
+
+	return append(wildcards, TargetWildcard)
+}
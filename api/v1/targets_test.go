@@ -0,0 +1,35 @@
+package v1_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+)
+
+func TestValidateTarget(t *testing.T) {
+	t.Parallel()
+
+	for _, target := range []string{"compute.instances.create", "compute.instances.*", "compute.*", "*"} {
+		assert.NoError(t, apiv1.ValidateTarget(target), target)
+	}
+
+	for _, target := range []string{"", "compute..create", "compute.", "compute.*.create", "compute.inst*"} {
+		assert.ErrorIs(t, apiv1.ValidateTarget(target), apiv1.ErrInvalidTarget, target)
+	}
+}
+
+func TestTargetWildcards(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t,
+		[]string{"compute.instances.*", "compute.*", "*"},
+		apiv1.TargetWildcards("compute.instances.create"),
+	)
+	assert.Equal(t, []string{"*"}, apiv1.TargetWildcards("compute"))
+
+	assert.True(t, apiv1.IsWildcardTarget("compute.*"))
+	assert.True(t, apiv1.IsWildcardTarget("*"))
+	assert.False(t, apiv1.IsWildcardTarget("compute.create"))
+}
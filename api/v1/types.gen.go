@@ -7,29 +7,303 @@ import (
 	"time"
 )
 
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for AssignmentChangeOp.
+const (
+	Assign   AssignmentChangeOp = "assign"
+	Unassign AssignmentChangeOp = "unassign"
+)
+
+// Defines values for AssignmentChangeResultStatus.
+const (
+	Applied    AssignmentChangeResultStatus = "applied"
+	Failed     AssignmentChangeResultStatus = "failed"
+	RolledBack AssignmentChangeResultStatus = "rolled_back"
+)
+
+// Defines values for ErrorReason.
+const (
+	Conflict           ErrorReason = "conflict"
+	Forbidden          ErrorReason = "forbidden"
+	Internal           ErrorReason = "internal"
+	InvalidArgument    ErrorReason = "invalid_argument"
+	InvalidReference   ErrorReason = "invalid_reference"
+	NotFound           ErrorReason = "not_found"
+	PreconditionFailed ErrorReason = "precondition_failed"
+	Unauthenticated    ErrorReason = "unauthenticated"
+)
+
+// Defines values for SortOrder.
+const (
+	Asc  SortOrder = "asc"
+	Desc SortOrder = "desc"
+)
+
+// Defines values for GetPermissionsParamsSort.
+const (
+	GetPermissionsParamsSortCreatedAt GetPermissionsParamsSort = "createdAt"
+	GetPermissionsParamsSortTarget    GetPermissionsParamsSort = "target"
+	GetPermissionsParamsSortUpdatedAt GetPermissionsParamsSort = "updatedAt"
+)
+
+// Defines values for GetRolesParamsSort.
+const (
+	GetRolesParamsSortCreatedAt GetRolesParamsSort = "createdAt"
+	GetRolesParamsSortName      GetRolesParamsSort = "name"
+	GetRolesParamsSortUpdatedAt GetRolesParamsSort = "updatedAt"
+)
+
+// Defines values for GetRoleAssignmentsParamsSort.
+const (
+	GetRoleAssignmentsParamsSortCreatedAt GetRoleAssignmentsParamsSort = "createdAt"
+	GetRoleAssignmentsParamsSortScope     GetRoleAssignmentsParamsSort = "scope"
+	GetRoleAssignmentsParamsSortSubject   GetRoleAssignmentsParamsSort = "subject"
+)
+
+// Defines values for GetRolePermissionsParamsSort.
+const (
+	GetRolePermissionsParamsSortCreatedAt GetRolePermissionsParamsSort = "createdAt"
+	GetRolePermissionsParamsSortTarget    GetRolePermissionsParamsSort = "target"
+	GetRolePermissionsParamsSortUpdatedAt GetRolePermissionsParamsSort = "updatedAt"
+)
+
 // Assignment defines model for Assignment.
 type Assignment struct {
-	Role    EntityID `json:"role"`
-	Scope   string   `json:"scope"`
-	Subject string   `json:"subject"`
+	// ExpiresAt When the assignment expires. It never does if not set.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// NotBefore When the assignment becomes active. It's active right away
+	// if not set.
+	NotBefore *time.Time `json:"notBefore,omitempty"`
+	Role      EntityID   `json:"role"`
+	Scope     string     `json:"scope"`
+	Subject   string     `json:"subject"`
+}
+
+// AssignmentChange defines model for AssignmentChange.
+type AssignmentChange struct {
+	// ExpiresAt When the assignment expires. It never does if not set.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// NotBefore When the assignment becomes active. It's active right away
+	// if not set.
+	NotBefore *time.Time `json:"notBefore,omitempty"`
+
+	// Op Whether to assign or unassign the role. notBefore and
+	// expiresAt are only used to assign it.
+	Op      AssignmentChangeOp `json:"op"`
+	Role    EntityID           `json:"role"`
+	Scope   string             `json:"scope"`
+	Subject string             `json:"subject"`
+}
+
+// AssignmentChangeOp Whether to assign or unassign the role. notBefore and
+// expiresAt are only used to assign it.
+type AssignmentChangeOp string
+
+// AssignmentChangeResult defines model for AssignmentChangeResult.
+type AssignmentChangeResult struct {
+	Error *Error `json:"error,omitempty"`
+
+	// Status - applied: the change was made
+	// - failed: the change couldn't be made, see error
+	// - rolled_back: the change could be made, but wasn't since
+	//   another change of the atomic batch failed
+	Status AssignmentChangeResultStatus `json:"status"`
+}
+
+// AssignmentChangeResultStatus - applied: the change was made
+//   - failed: the change couldn't be made, see error
+//   - rolled_back: the change could be made, but wasn't since
+//     another change of the atomic batch failed
+type AssignmentChangeResultStatus string
+
+// AssignmentList defines model for AssignmentList.
+type AssignmentList struct {
+	Items []Assignment `json:"items"`
+
+	// NextCursor cursor of the next page, not set on the last page
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+// AuditEntry defines model for AuditEntry.
+type AuditEntry struct {
+	// Action what was done, named after the event published for it
+	Action string `json:"action"`
+
+	// Actor subject who made the change, not set for changes that weren't
+	// made on behalf of a known subject
+	Actor *string `json:"actor,omitempty"`
+
+	// After the objects as they are after the change, not set for deletions
+	After *map[string]interface{} `json:"after,omitempty"`
+
+	// Before the objects as they were before the change, not set for creations
+	Before *map[string]interface{} `json:"before,omitempty"`
+	Id     string                  `json:"id"`
+
+	// Objects IDs of the objects involved in the change
+	Objects []string  `json:"objects"`
+	Time    time.Time `json:"time"`
+}
+
+// AuditLog defines model for AuditLog.
+type AuditLog struct {
+	Entries []AuditEntry `json:"entries"`
+
+	// NextCursor cursor of the next page, not set on the last page
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+// BatchAssignmentRequest defines model for BatchAssignmentRequest.
+type BatchAssignmentRequest struct {
+	// Atomic whether to apply no change at all if any fails
+	Atomic  *bool              `json:"atomic,omitempty"`
+	Changes []AssignmentChange `json:"changes"`
+}
+
+// BatchAssignmentResult defines model for BatchAssignmentResult.
+type BatchAssignmentResult struct {
+	// Applied whether the changes that didn't fail were made
+	Applied bool                     `json:"applied"`
+	Results []AssignmentChangeResult `json:"results"`
+}
+
+// BatchCheckRequest defines model for BatchCheckRequest.
+type BatchCheckRequest struct {
+	Checks []CheckRequest `json:"checks"`
+}
+
+// BatchCheckResult defines model for BatchCheckResult.
+type BatchCheckResult struct {
+	Results []CheckResult `json:"results"`
+}
+
+// CheckRequest defines model for CheckRequest.
+type CheckRequest struct {
+	Scope   string `json:"scope"`
+	Subject string `json:"subject"`
+	Target  string `json:"target"`
+}
+
+// CheckResult defines model for CheckResult.
+type CheckResult struct {
+	Allowed bool      `json:"allowed"`
+	Deny    *DenyRule `json:"deny,omitempty"`
+
+	// Role ID of the role that granted the permission
+	Role *EntityID `json:"role,omitempty"`
+}
+
+// DenyRule defines model for DenyRule.
+type DenyRule struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Id        EntityID  `json:"id"`
+	Reason    *string   `json:"reason,omitempty"`
+	Role      *EntityID `json:"role,omitempty"`
+	Scope     string    `json:"scope"`
+	Subject   *string   `json:"subject,omitempty"`
+
+	// Target target to deny, which may be a wildcard
+	Target string `json:"target"`
 }
 
 // Error defines model for Error.
 type Error struct {
-	Code    int32  `json:"code"`
+	// Code HTTP status code of the response
+	Code int32 `json:"code"`
+
+	// Message human-readable description of the error
 	Message string `json:"message"`
+
+	// Reason Machine-readable reason of the error:
+	// - invalid_argument: the request is malformed
+	// - unauthenticated: the request isn't authenticated
+	// - forbidden: the subject isn't allowed to make the request
+	// - not_found: an object the request is about doesn't exist
+	// - conflict: the request conflicts with the current state, e.g.
+	//   an object with the same name already exists
+	// - precondition_failed: a precondition of the request doesn't
+	//   hold anymore
+	// - invalid_reference: the request refers to objects that don't
+	//   exist or can't be used, e.g. an untracked subject
+	// - internal: the request couldn't be processed
+	Reason ErrorReason `json:"reason"`
+}
+
+// ErrorReason Machine-readable reason of the error:
+//   - invalid_argument: the request is malformed
+//   - unauthenticated: the request isn't authenticated
+//   - forbidden: the subject isn't allowed to make the request
+//   - not_found: an object the request is about doesn't exist
+//   - conflict: the request conflicts with the current state, e.g.
+//     an object with the same name already exists
+//   - precondition_failed: a precondition of the request doesn't
+//     hold anymore
+//   - invalid_reference: the request refers to objects that don't
+//     exist or can't be used, e.g. an untracked subject
+//   - internal: the request couldn't be processed
+type ErrorReason string
+
+// Group defines model for Group.
+type Group struct {
+	CreatedAt   time.Time `json:"createdAt"`
+	Description *string   `json:"description,omitempty"`
+
+	// Id subject ID of the group
+	Id        string    `json:"id"`
+	Name      string    `json:"name"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// NewDenyRule Exactly one of subject or role must be set. A role deny rule
+// applies to every subject holding the role on the scope.
+type NewDenyRule struct {
+	Reason  *string   `json:"reason,omitempty"`
+	Role    *EntityID `json:"role,omitempty"`
+	Scope   string    `json:"scope"`
+	Subject *string   `json:"subject,omitempty"`
+
+	// Target target to deny, which may be a wildcard
+	Target string `json:"target"`
+}
+
+// NewGroup defines model for NewGroup.
+type NewGroup struct {
+	Description *string `json:"description,omitempty"`
+	Name        string  `json:"name"`
 }
 
 // NewRole defines model for NewRole.
 type NewRole struct {
 	Description *string `json:"description,omitempty"`
-	Name        string  `json:"name"`
+
+	// Directory The directory to define the role in. The role can only be
+	// assigned in the directory's subtree. Leave empty to define
+	// a global role.
+	Directory *string `json:"directory,omitempty"`
+	Name      string  `json:"name"`
 }
 
 // NewRoleAssignment defines model for NewRoleAssignment.
 type NewRoleAssignment struct {
-	Scope   string `json:"scope"`
-	Subject string `json:"subject"`
+	// ExpiresAt When the assignment expires. It never does if not set.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// NotBefore When the assignment becomes active. It's active right away
+	// if not set.
+	NotBefore *time.Time `json:"notBefore,omitempty"`
+	Scope     string     `json:"scope"`
+	Subject   string     `json:"subject"`
+}
+
+// Page defines model for Page.
+type Page struct {
+	// NextCursor cursor of the next page, not set on the last page
+	NextCursor *string `json:"nextCursor,omitempty"`
 }
 
 // Permission defines model for Permission.
@@ -43,25 +317,137 @@ type PermissionIdentifier struct {
 	Target string `json:"target"`
 }
 
+// PermissionList defines model for PermissionList.
+type PermissionList struct {
+	Items []Permission `json:"items"`
+
+	// NextCursor cursor of the next page, not set on the last page
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
 // Role defines model for Role.
 type Role struct {
-	CreatedAt   time.Time     `json:"createdAt"`
-	Description *string       `json:"description,omitempty"`
+	CreatedAt   time.Time `json:"createdAt"`
+	Description *string   `json:"description,omitempty"`
+
+	// Directory The directory the role is defined in. Roles without a
+	// directory are global.
+	Directory   *string       `json:"directory,omitempty"`
 	Id          EntityID      `json:"id"`
 	Name        string        `json:"name"`
 	Permissions *[]Permission `json:"permissions,omitempty"`
 	UpdatedAt   time.Time     `json:"updatedAt"`
+
+	// Version Version of the role, incremented whenever the role or its
+	// permissions change. It's also returned as the role's ETag.
+	Version *int64 `json:"version,omitempty"`
+}
+
+// RoleIdentifier defines model for RoleIdentifier.
+type RoleIdentifier struct {
+	Id EntityID `json:"id"`
 }
 
 // RoleInfo defines model for RoleInfo.
 type RoleInfo struct {
 	CreatedAt   time.Time `json:"createdAt"`
 	Description *string   `json:"description,omitempty"`
-	Id          EntityID  `json:"id"`
-	Name        string    `json:"name"`
-	UpdatedAt   time.Time `json:"updatedAt"`
+
+	// Directory The directory the role is defined in. Roles without a
+	// directory are global.
+	Directory *string   `json:"directory,omitempty"`
+	Id        EntityID  `json:"id"`
+	Name      string    `json:"name"`
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// Version Version of the role, incremented whenever the role or its
+	// permissions change. It's also returned as the role's ETag.
+	Version *int64 `json:"version,omitempty"`
+}
+
+// RoleList defines model for RoleList.
+type RoleList struct {
+	Items []RoleInfo `json:"items"`
+
+	// NextCursor cursor of the next page, not set on the last page
+	NextCursor *string `json:"nextCursor,omitempty"`
 }
 
+// RoleUpdate The changes to make to a role. The directory a role is defined
+// in can't be changed.
+type RoleUpdate struct {
+	Description *string `json:"description,omitempty"`
+	Name        string  `json:"name"`
+}
+
+// ServicePermissionManifest defines model for ServicePermissionManifest.
+type ServicePermissionManifest struct {
+	Permissions []Permission `json:"permissions"`
+}
+
+// ServicePermissionsDiff defines model for ServicePermissionsDiff.
+type ServicePermissionsDiff struct {
+	// Added targets that were registered
+	Added []string `json:"added"`
+
+	// Retained targets that are no longer declared but were kept because
+	// roles still use them
+	Retained []string `json:"retained"`
+
+	// Retired targets that are no longer declared and were removed
+	Retired []string `json:"retired"`
+
+	// Updated targets whose description was updated
+	Updated []string `json:"updated"`
+}
+
+// SortOrder defines model for SortOrder.
+type SortOrder string
+
+// Subject defines model for Subject.
+type Subject struct {
+	Id string `json:"id"`
+}
+
+// SubjectDeletion What's removed along with a subject. When the subject is a
+// group, group is the group deleted with it.
+type SubjectDeletion struct {
+	// Assignments role assignments removed with the subject
+	Assignments []Assignment `json:"assignments"`
+
+	// Deleted whether the subject was deleted, false for dry runs
+	Deleted bool `json:"deleted"`
+
+	// DenyRules deny rules removed with the subject
+	DenyRules []DenyRule `json:"denyRules"`
+
+	// EffectivePermissions number of effective permissions removed with the subject
+	EffectivePermissions int64  `json:"effectivePermissions"`
+	Group                *Group `json:"group,omitempty"`
+
+	// Members members removed from the group, empty unless the subject is a group
+	Members []Subject `json:"members"`
+
+	// Memberships groups the subject is removed from
+	Memberships []Group `json:"memberships"`
+	Subject     string  `json:"subject"`
+}
+
+// Cursor defines model for cursor.
+type Cursor = string
+
+// IdempotencyKey defines model for idempotencyKey.
+type IdempotencyKey = string
+
+// IfMatch defines model for ifMatch.
+type IfMatch = string
+
+// Limit defines model for limit.
+type Limit = int
+
+// Order defines model for order.
+type Order = SortOrder
+
 // GetAssignmentsParams defines parameters for GetAssignments.
 type GetAssignmentsParams struct {
 	// Subject subject to return assignments for
@@ -74,17 +460,494 @@ type GetAssignmentsParams struct {
 	Role *EntityID `form:"role,omitempty" json:"role,omitempty"`
 }
 
+// ChangeAssignmentsParams defines parameters for ChangeAssignments.
+type ChangeAssignmentsParams struct {
+	// IdempotencyKey Unique key of the request, making it safe to retry. A request
+	// made again with the same key, e.g. after a timeout, isn't applied
+	// again but returns the original response, with the
+	// Idempotent-Replayed header set, until the key expires. The key
+	// can't be reused for another request, and fails with 409 while
+	// the original request is still being processed.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetAuditEntriesParams defines parameters for GetAuditEntries.
+type GetAuditEntriesParams struct {
+	// Actor subject who made the changes
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// Object ID of an object involved in the changes, such as a role ID,
+	// a subject ID, a scope or a permission target
+	Object *string `form:"object,omitempty" json:"object,omitempty"`
+
+	// Action action of the changes, e.g. roles.updated
+	Action *string `form:"action,omitempty" json:"action,omitempty"`
+
+	// Since return changes made at or after this time
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until return changes made before this time
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+
+	// Limit maximum number of results to return
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor cursor of the page to return, from a previous response. It's only
+	// valid with the same sort and order as that response.
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// CheckPermissionParams defines parameters for CheckPermission.
+type CheckPermissionParams struct {
+	// IdempotencyKey Unique key of the request, making it safe to retry. A request
+	// made again with the same key, e.g. after a timeout, isn't applied
+	// again but returns the original response, with the
+	// Idempotent-Replayed header set, until the key expires. The key
+	// can't be reused for another request, and fails with 409 while
+	// the original request is still being processed.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// CheckPermissionsParams defines parameters for CheckPermissions.
+type CheckPermissionsParams struct {
+	// IdempotencyKey Unique key of the request, making it safe to retry. A request
+	// made again with the same key, e.g. after a timeout, isn't applied
+	// again but returns the original response, with the
+	// Idempotent-Replayed header set, until the key expires. The key
+	// can't be reused for another request, and fails with 409 while
+	// the original request is still being processed.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetDenyRulesParams defines parameters for GetDenyRules.
+type GetDenyRulesParams struct {
+	// Subject subject to return deny rules for
+	Subject *string `form:"subject,omitempty" json:"subject,omitempty"`
+
+	// Role role to return deny rules for
+	Role *EntityID `form:"role,omitempty" json:"role,omitempty"`
+
+	// Scope scope to return deny rules for
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty"`
+}
+
+// CreateDenyRuleParams defines parameters for CreateDenyRule.
+type CreateDenyRuleParams struct {
+	// IdempotencyKey Unique key of the request, making it safe to retry. A request
+	// made again with the same key, e.g. after a timeout, isn't applied
+	// again but returns the original response, with the
+	// Idempotent-Replayed header set, until the key expires. The key
+	// can't be reused for another request, and fails with 409 while
+	// the original request is still being processed.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// DeleteDenyRuleParams defines parameters for DeleteDenyRule.
+type DeleteDenyRuleParams struct {
+	// IdempotencyKey Unique key of the request, making it safe to retry. A request
+	// made again with the same key, e.g. after a timeout, isn't applied
+	// again but returns the original response, with the
+	// Idempotent-Replayed header set, until the key expires. The key
+	// can't be reused for another request, and fails with 409 while
+	// the original request is still being processed.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// CreateGroupParams defines parameters for CreateGroup.
+type CreateGroupParams struct {
+	// IdempotencyKey Unique key of the request, making it safe to retry. A request
+	// made again with the same key, e.g. after a timeout, isn't applied
+	// again but returns the original response, with the
+	// Idempotent-Replayed header set, until the key expires. The key
+	// can't be reused for another request, and fails with 409 while
+	// the original request is still being processed.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// DeleteGroupParams defines parameters for DeleteGroup.
+type DeleteGroupParams struct {
+	// IdempotencyKey Unique key of the request, making it safe to retry. A request
+	// made again with the same key, e.g. after a timeout, isn't applied
+	// again but returns the original response, with the
+	// Idempotent-Replayed header set, until the key expires. The key
+	// can't be reused for another request, and fails with 409 while
+	// the original request is still being processed.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// UpdateGroupParams defines parameters for UpdateGroup.
+type UpdateGroupParams struct {
+	// IdempotencyKey Unique key of the request, making it safe to retry. A request
+	// made again with the same key, e.g. after a timeout, isn't applied
+	// again but returns the original response, with the
+	// Idempotent-Replayed header set, until the key expires. The key
+	// can't be reused for another request, and fails with 409 while
+	// the original request is still being processed.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// RemoveGroupMemberParams defines parameters for RemoveGroupMember.
+type RemoveGroupMemberParams struct {
+	// IdempotencyKey Unique key of the request, making it safe to retry. A request
+	// made again with the same key, e.g. after a timeout, isn't applied
+	// again but returns the original response, with the
+	// Idempotent-Replayed header set, until the key expires. The key
+	// can't be reused for another request, and fails with 409 while
+	// the original request is still being processed.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// AddGroupMemberParams defines parameters for AddGroupMember.
+type AddGroupMemberParams struct {
+	// IdempotencyKey Unique key of the request, making it safe to retry. A request
+	// made again with the same key, e.g. after a timeout, isn't applied
+	// again but returns the original response, with the
+	// Idempotent-Replayed header set, until the key expires. The key
+	// can't be reused for another request, and fails with 409 while
+	// the original request is still being processed.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
 // GetPermissionsParams defines parameters for GetPermissions.
 type GetPermissionsParams struct {
 	// Target target to return permission information for
 	Target *string `form:"target,omitempty" json:"target,omitempty"`
+
+	// Prefix return the permissions whose target starts with this prefix
+	Prefix *string `form:"prefix,omitempty" json:"prefix,omitempty"`
+
+	// Sort field to sort the permissions on
+	Sort *GetPermissionsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order order to sort results in
+	Order *Order `form:"order,omitempty" json:"order,omitempty"`
+
+	// Limit maximum number of results to return
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor cursor of the page to return, from a previous response. It's only
+	// valid with the same sort and order as that response.
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetPermissionsParamsSort defines parameters for GetPermissions.
+type GetPermissionsParamsSort string
+
+// CreatePermissionParams defines parameters for CreatePermission.
+type CreatePermissionParams struct {
+	// IdempotencyKey Unique key of the request, making it safe to retry. A request
+	// made again with the same key, e.g. after a timeout, isn't applied
+	// again but returns the original response, with the
+	// Idempotent-Replayed header set, until the key expires. The key
+	// can't be reused for another request, and fails with 409 while
+	// the original request is still being processed.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// DeletePermissionParams defines parameters for DeletePermission.
+type DeletePermissionParams struct {
+	// IdempotencyKey Unique key of the request, making it safe to retry. A request
+	// made again with the same key, e.g. after a timeout, isn't applied
+	// again but returns the original response, with the
+	// Idempotent-Replayed header set, until the key expires. The key
+	// can't be reused for another request, and fails with 409 while
+	// the original request is still being processed.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// UpdatePermissionParams defines parameters for UpdatePermission.
+type UpdatePermissionParams struct {
+	// IdempotencyKey Unique key of the request, making it safe to retry. A request
+	// made again with the same key, e.g. after a timeout, isn't applied
+	// again but returns the original response, with the
+	// Idempotent-Replayed header set, until the key expires. The key
+	// can't be reused for another request, and fails with 409 while
+	// the original request is still being processed.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
 }
 
+// GetRolesParams defines parameters for GetRoles.
+type GetRolesParams struct {
+	// Scope directory to return the assignable roles for
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty"`
+
+	// Name name of the roles to return
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+
+	// Prefix return the roles whose name starts with this prefix
+	Prefix *string `form:"prefix,omitempty" json:"prefix,omitempty"`
+
+	// Sort field to sort the roles on
+	Sort *GetRolesParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order order to sort results in
+	Order *Order `form:"order,omitempty" json:"order,omitempty"`
+
+	// Limit maximum number of results to return
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor cursor of the page to return, from a previous response. It's only
+	// valid with the same sort and order as that response.
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetRolesParamsSort defines parameters for GetRoles.
+type GetRolesParamsSort string
+
+// CreateRoleParams defines parameters for CreateRole.
+type CreateRoleParams struct {
+	// IdempotencyKey Unique key of the request, making it safe to retry. A request
+	// made again with the same key, e.g. after a timeout, isn't applied
+	// again but returns the original response, with the
+	// Idempotent-Replayed header set, until the key expires. The key
+	// can't be reused for another request, and fails with 409 while
+	// the original request is still being processed.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// DeleteRoleParams defines parameters for DeleteRole.
+type DeleteRoleParams struct {
+	// IfMatch ETag of the version of the role the change is based on. The
+	// change is only made if the role is still at that version, and
+	// fails with 412 otherwise.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+
+	// IdempotencyKey Unique key of the request, making it safe to retry. A request
+	// made again with the same key, e.g. after a timeout, isn't applied
+	// again but returns the original response, with the
+	// Idempotent-Replayed header set, until the key expires. The key
+	// can't be reused for another request, and fails with 409 while
+	// the original request is still being processed.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// UpdateRoleParams defines parameters for UpdateRole.
+type UpdateRoleParams struct {
+	// IfMatch ETag of the version of the role the change is based on. The
+	// change is only made if the role is still at that version, and
+	// fails with 412 otherwise.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+
+	// IdempotencyKey Unique key of the request, making it safe to retry. A request
+	// made again with the same key, e.g. after a timeout, isn't applied
+	// again but returns the original response, with the
+	// Idempotent-Replayed header set, until the key expires. The key
+	// can't be reused for another request, and fails with 409 while
+	// the original request is still being processed.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// RemoveRoleAssignmentParams defines parameters for RemoveRoleAssignment.
+type RemoveRoleAssignmentParams struct {
+	// IdempotencyKey Unique key of the request, making it safe to retry. A request
+	// made again with the same key, e.g. after a timeout, isn't applied
+	// again but returns the original response, with the
+	// Idempotent-Replayed header set, until the key expires. The key
+	// can't be reused for another request, and fails with 409 while
+	// the original request is still being processed.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetRoleAssignmentsParams defines parameters for GetRoleAssignments.
+type GetRoleAssignmentsParams struct {
+	// Subject subject to return the assignments of
+	Subject *string `form:"subject,omitempty" json:"subject,omitempty"`
+
+	// Prefix return the assignments of the subjects whose ID starts with this prefix
+	Prefix *string `form:"prefix,omitempty" json:"prefix,omitempty"`
+
+	// Scope scope to return the assignments on
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty"`
+
+	// Sort field to sort the assignments on
+	Sort *GetRoleAssignmentsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order order to sort results in
+	Order *Order `form:"order,omitempty" json:"order,omitempty"`
+
+	// Limit maximum number of results to return
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor cursor of the page to return, from a previous response. It's only
+	// valid with the same sort and order as that response.
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetRoleAssignmentsParamsSort defines parameters for GetRoleAssignments.
+type GetRoleAssignmentsParamsSort string
+
+// AssignRoleParams defines parameters for AssignRole.
+type AssignRoleParams struct {
+	// IdempotencyKey Unique key of the request, making it safe to retry. A request
+	// made again with the same key, e.g. after a timeout, isn't applied
+	// again but returns the original response, with the
+	// Idempotent-Replayed header set, until the key expires. The key
+	// can't be reused for another request, and fails with 409 while
+	// the original request is still being processed.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// RemoveRoleIncludeParams defines parameters for RemoveRoleInclude.
+type RemoveRoleIncludeParams struct {
+	// IfMatch ETag of the version of the role the change is based on. The
+	// change is only made if the role is still at that version, and
+	// fails with 412 otherwise.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+
+	// IdempotencyKey Unique key of the request, making it safe to retry. A request
+	// made again with the same key, e.g. after a timeout, isn't applied
+	// again but returns the original response, with the
+	// Idempotent-Replayed header set, until the key expires. The key
+	// can't be reused for another request, and fails with 409 while
+	// the original request is still being processed.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// AddRoleIncludeParams defines parameters for AddRoleInclude.
+type AddRoleIncludeParams struct {
+	// IfMatch ETag of the version of the role the change is based on. The
+	// change is only made if the role is still at that version, and
+	// fails with 412 otherwise.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+
+	// IdempotencyKey Unique key of the request, making it safe to retry. A request
+	// made again with the same key, e.g. after a timeout, isn't applied
+	// again but returns the original response, with the
+	// Idempotent-Replayed header set, until the key expires. The key
+	// can't be reused for another request, and fails with 409 while
+	// the original request is still being processed.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// RemoveRolePermissionParams defines parameters for RemoveRolePermission.
+type RemoveRolePermissionParams struct {
+	// IfMatch ETag of the version of the role the change is based on. The
+	// change is only made if the role is still at that version, and
+	// fails with 412 otherwise.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+
+	// IdempotencyKey Unique key of the request, making it safe to retry. A request
+	// made again with the same key, e.g. after a timeout, isn't applied
+	// again but returns the original response, with the
+	// Idempotent-Replayed header set, until the key expires. The key
+	// can't be reused for another request, and fails with 409 while
+	// the original request is still being processed.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetRolePermissionsParams defines parameters for GetRolePermissions.
+type GetRolePermissionsParams struct {
+	// Expand Also return the permissions granted through the roles the
+	// role includes, directly or not.
+	Expand *bool `form:"expand,omitempty" json:"expand,omitempty"`
+
+	// Prefix return the permissions whose target starts with this prefix
+	Prefix *string `form:"prefix,omitempty" json:"prefix,omitempty"`
+
+	// Sort field to sort the permissions on
+	Sort *GetRolePermissionsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order order to sort results in
+	Order *Order `form:"order,omitempty" json:"order,omitempty"`
+
+	// Limit maximum number of results to return
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor cursor of the page to return, from a previous response. It's only
+	// valid with the same sort and order as that response.
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetRolePermissionsParamsSort defines parameters for GetRolePermissions.
+type GetRolePermissionsParamsSort string
+
+// AddRolePermissionParams defines parameters for AddRolePermission.
+type AddRolePermissionParams struct {
+	// IfMatch ETag of the version of the role the change is based on. The
+	// change is only made if the role is still at that version, and
+	// fails with 412 otherwise.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+
+	// IdempotencyKey Unique key of the request, making it safe to retry. A request
+	// made again with the same key, e.g. after a timeout, isn't applied
+	// again but returns the original response, with the
+	// Idempotent-Replayed header set, until the key expires. The key
+	// can't be reused for another request, and fails with 409 while
+	// the original request is still being processed.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// RegisterServicePermissionsParams defines parameters for RegisterServicePermissions.
+type RegisterServicePermissionsParams struct {
+	// IdempotencyKey Unique key of the request, making it safe to retry. A request
+	// made again with the same key, e.g. after a timeout, isn't applied
+	// again but returns the original response, with the
+	// Idempotent-Replayed header set, until the key expires. The key
+	// can't be reused for another request, and fails with 409 while
+	// the original request is still being processed.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// CreateSubjectParams defines parameters for CreateSubject.
+type CreateSubjectParams struct {
+	// IdempotencyKey Unique key of the request, making it safe to retry. A request
+	// made again with the same key, e.g. after a timeout, isn't applied
+	// again but returns the original response, with the
+	// Idempotent-Replayed header set, until the key expires. The key
+	// can't be reused for another request, and fails with 409 while
+	// the original request is still being processed.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// DeleteSubjectParams defines parameters for DeleteSubject.
+type DeleteSubjectParams struct {
+	// DryRun only preview what would be removed
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+
+	// IdempotencyKey Unique key of the request, making it safe to retry. A request
+	// made again with the same key, e.g. after a timeout, isn't applied
+	// again but returns the original response, with the
+	// Idempotent-Replayed header set, until the key expires. The key
+	// can't be reused for another request, and fails with 409 while
+	// the original request is still being processed.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// ChangeAssignmentsJSONRequestBody defines body for ChangeAssignments for application/json ContentType.
+type ChangeAssignmentsJSONRequestBody = BatchAssignmentRequest
+
+// CheckPermissionJSONRequestBody defines body for CheckPermission for application/json ContentType.
+type CheckPermissionJSONRequestBody = CheckRequest
+
+// CheckPermissionsJSONRequestBody defines body for CheckPermissions for application/json ContentType.
+type CheckPermissionsJSONRequestBody = BatchCheckRequest
+
+// CreateDenyRuleJSONRequestBody defines body for CreateDenyRule for application/json ContentType.
+type CreateDenyRuleJSONRequestBody = NewDenyRule
+
+// CreateGroupJSONRequestBody defines body for CreateGroup for application/json ContentType.
+type CreateGroupJSONRequestBody = NewGroup
+
+// UpdateGroupJSONRequestBody defines body for UpdateGroup for application/json ContentType.
+type UpdateGroupJSONRequestBody = NewGroup
+
+// RemoveGroupMemberJSONRequestBody defines body for RemoveGroupMember for application/json ContentType.
+type RemoveGroupMemberJSONRequestBody = Subject
+
+// AddGroupMemberJSONRequestBody defines body for AddGroupMember for application/json ContentType.
+type AddGroupMemberJSONRequestBody = Subject
+
+// CreatePermissionJSONRequestBody defines body for CreatePermission for application/json ContentType.
+type CreatePermissionJSONRequestBody = Permission
+
+// UpdatePermissionJSONRequestBody defines body for UpdatePermission for application/json ContentType.
+type UpdatePermissionJSONRequestBody = Permission
+
 // CreateRoleJSONRequestBody defines body for CreateRole for application/json ContentType.
 type CreateRoleJSONRequestBody = NewRole
 
 // UpdateRoleJSONRequestBody defines body for UpdateRole for application/json ContentType.
-type UpdateRoleJSONRequestBody = Role
+type UpdateRoleJSONRequestBody = RoleUpdate
 
 // RemoveRoleAssignmentJSONRequestBody defines body for RemoveRoleAssignment for application/json ContentType.
 type RemoveRoleAssignmentJSONRequestBody = NewRoleAssignment
@@ -92,8 +955,20 @@ type RemoveRoleAssignmentJSONRequestBody = NewRoleAssignment
 // AssignRoleJSONRequestBody defines body for AssignRole for application/json ContentType.
 type AssignRoleJSONRequestBody = NewRoleAssignment
 
+// RemoveRoleIncludeJSONRequestBody defines body for RemoveRoleInclude for application/json ContentType.
+type RemoveRoleIncludeJSONRequestBody = RoleIdentifier
+
+// AddRoleIncludeJSONRequestBody defines body for AddRoleInclude for application/json ContentType.
+type AddRoleIncludeJSONRequestBody = RoleIdentifier
+
 // RemoveRolePermissionJSONRequestBody defines body for RemoveRolePermission for application/json ContentType.
 type RemoveRolePermissionJSONRequestBody = PermissionIdentifier
 
 // AddRolePermissionJSONRequestBody defines body for AddRolePermission for application/json ContentType.
 type AddRolePermissionJSONRequestBody = PermissionIdentifier
+
+// RegisterServicePermissionsJSONRequestBody defines body for RegisterServicePermissions for application/json ContentType.
+type RegisterServicePermissionsJSONRequestBody = ServicePermissionManifest
+
+// CreateSubjectJSONRequestBody defines body for CreateSubject for application/json ContentType.
+type CreateSubjectJSONRequestBody = Subject
@@ -12,3 +12,14 @@ func ParseEntityID(s string) (EntityID, error) {
 func (id EntityID) String() string {
 	return uuid.UUID(id).String()
 }
+
+// MarshalText encodes IDs as UUID strings, in JSON too.
+func (id EntityID) MarshalText() ([]byte, error) {
+	return uuid.UUID(id).MarshalText()
+}
+
+// UnmarshalText parses IDs from UUID strings, which is also how they're
+// bound from path and query parameters.
+func (id *EntityID) UnmarshalText(data []byte) error {
+	return (*uuid.UUID)(id).UnmarshalText(data)
+}
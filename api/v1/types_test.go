@@ -0,0 +1,28 @@
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+)
+
+func TestEntityIDJSON(t *testing.T) {
+	t.Parallel()
+
+	id, err := apiv1.ParseEntityID("8c1d2e3f-4a5b-4c6d-8e7f-9a0b1c2d3e4f")
+	require.NoError(t, err)
+
+	data, err := json.Marshal(apiv1.RoleIdentifier{Id: id})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id": "8c1d2e3f-4a5b-4c6d-8e7f-9a0b1c2d3e4f"}`, string(data))
+
+	var ref apiv1.RoleIdentifier
+	require.NoError(t, json.Unmarshal(data, &ref))
+	assert.Equal(t, id, ref.Id)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"id": "not-a-uuid"}`), &ref))
+}
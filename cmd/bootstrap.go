@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	fsv1 "github.com/infratographer/fertilesoil/api/v1"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.infratographer.com/x/crdbx"
+	"go.infratographer.com/x/viperx"
+	"go.uber.org/zap"
+
+	"github.com/infratographer/lmi/internal/bootstrap"
+	"github.com/infratographer/lmi/internal/storage"
+	sqlstore "github.com/infratographer/lmi/internal/storage/sql"
+	dbutils "github.com/infratographer/lmi/internal/storage/sql/utils"
+)
+
+// bootstrapCmd represents the bootstrap command.
+var bootstrapCmd = &cobra.Command{
+	Use:   "bootstrap",
+	Short: "Seeds an initial admin of LMI",
+	Long: `Seeds an initial admin of LMI by assigning it the lmi-admin role,
+which grants all of LMI's own permissions, on the base directory. The
+role is created if needed.
+
+The base directory must have been synced by lmi serve beforehand.`,
+	RunE: runBootstrap,
+}
+
+//nolint:gochecknoinits // This is a Cobra generated file
+func init() {
+	rootCmd.AddCommand(bootstrapCmd)
+
+	v := viper.GetViper()
+	flags := bootstrapCmd.Flags()
+
+	crdbx.MustViperFlags(v, flags)
+
+	// These are bound when the command runs, since serve binds the
+	// base directory to its own flag.
+	flags.String("base-directory-id", "", "ID of the base directory for this lmi instance")
+	flags.String("admin", "", "ID of the subject to make an admin of LMI")
+}
+
+func runBootstrap(cmd *cobra.Command, args []string) error {
+	v := viper.GetViper()
+
+	viperx.MustBindFlag(v, "base_directory_id", cmd.Flags().Lookup("base-directory-id"))
+	viperx.MustBindFlag(v, "bootstrap.admin", cmd.Flags().Lookup("admin"))
+
+	// Initialize logger
+	logger := initLogger()
+
+	admin := v.GetString("bootstrap.admin")
+	if admin == "" {
+		return errors.New("an admin subject is required") //nolint:goerr113 // only shown to the user
+	}
+
+	baseDirID, err := uuid.Parse(v.GetString("base_directory_id"))
+	if err != nil {
+		return fmt.Errorf("failed to parse base directory id: %w", err)
+	}
+
+	// Initialize database connection
+	dbconn, err := dbutils.GetDBConnection(v, defaultDBName, false)
+	if err != nil {
+		return fmt.Errorf("failed to get db connection: %w", err)
+	}
+
+	defer dbconn.Close()
+
+	store := sqlstore.NewSQLDriver(dbconn)
+
+	ctx := cmd.Context()
+
+	if _, err := store.GetDirectoryParent(ctx, fsv1.DirectoryID(baseDirID)); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return fmt.Errorf("base directory %s isn't synced yet, run lmi serve first", baseDirID)
+		}
+
+		return fmt.Errorf("failed to check base directory: %w", err)
+	}
+
+	role, err := bootstrap.SeedAdmin(ctx, store, baseDirID.String(), admin)
+	if err != nil {
+		return fmt.Errorf("failed to seed admin: %w", err)
+	}
+
+	logger.Info("seeded admin",
+		zap.String("subject", admin),
+		zap.String("role", role.Id.String()),
+		zap.String("scope", baseDirID.String()),
+	)
+
+	return nil
+}
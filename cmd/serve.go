@@ -4,7 +4,11 @@ Copyright © 2022 NAME HERE <EMAIL ADDRESS>
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"regexp"
+	"time"
 
 	"github.com/google/uuid"
 	apiv1 "github.com/infratographer/fertilesoil/api/v1"
@@ -21,8 +25,13 @@ import (
 	"go.infratographer.com/x/viperx"
 	"go.uber.org/zap"
 
+	"github.com/infratographer/lmi/internal/bootstrap"
+	"github.com/infratographer/lmi/internal/events"
+	"github.com/infratographer/lmi/internal/httpsrv"
 	"github.com/infratographer/lmi/internal/reconciler"
+	sqlstore "github.com/infratographer/lmi/internal/storage/sql"
 	dbutils "github.com/infratographer/lmi/internal/storage/sql/utils"
+	"github.com/infratographer/lmi/internal/sweeper"
 )
 
 // serveCmd represents the serve command.
@@ -39,8 +48,16 @@ to quickly create a Cobra application.`,
 }
 
 const (
-	defaultListenAddr = ":8080"
-	defaultDBName     = "permissions"
+	defaultListenAddr     = ":8080"
+	defaultDBName         = "permissions"
+	defaultSweepInterval  = time.Minute
+	defaultRelayInterval  = time.Second
+	defaultEventRetention = 24 * time.Hour
+	defaultPruneInterval  = 10 * time.Minute
+
+	// defaultSubjectIDFormat matches infratographer URNs,
+	// e.g. urn:infratographer:user:<uuid>.
+	defaultSubjectIDFormat = `^urn:infratographer:[a-z][a-z0-9-]*:[0-9a-fA-F-]+$`
 )
 
 //nolint:gochecknoinits // This is a Cobra generated file
@@ -66,6 +83,62 @@ func init() {
 
 	flags.String("nats-nkey", "", "path to nkey file")
 	viperx.MustBindFlag(v, "nats.nkey", flags.Lookup("nats-nkey"))
+
+	flags.String("nats-permissions-subject", "infratographer.events.permissions",
+		"NATS subject prefix to publish permission events on")
+	viperx.MustBindFlag(v, "nats.permissions_subject", flags.Lookup("nats-permissions-subject"))
+
+	flags.Duration("events-relay-interval", defaultRelayInterval,
+		"how often to relay the events written to the outbox to NATS")
+	viperx.MustBindFlag(v, "events.relay_interval", flags.Lookup("events-relay-interval"))
+
+	flags.Duration("events-retention", defaultEventRetention,
+		"how long to keep delivered events in the outbox before pruning them")
+	viperx.MustBindFlag(v, "events.retention", flags.Lookup("events-retention"))
+
+	flags.Duration("assignments-sweep-interval", defaultSweepInterval,
+		"how often to sweep the permissions granted by expired role assignments")
+	viperx.MustBindFlag(v, "assignments.sweep_interval", flags.Lookup("assignments-sweep-interval"))
+
+	flags.Duration("idempotency-retention", httpsrv.DefaultIdempotencyRetention,
+		"how long to keep idempotency keys, and return their responses to retried requests")
+	viperx.MustBindFlag(v, "idempotency.retention", flags.Lookup("idempotency-retention"))
+
+	flags.Duration("idempotency-prune-interval", defaultPruneInterval,
+		"how often to prune the expired idempotency keys")
+	viperx.MustBindFlag(v, "idempotency.prune_interval", flags.Lookup("idempotency-prune-interval"))
+
+	flags.String("subject-id-format", defaultSubjectIDFormat,
+		"regular expression subject IDs must match. An empty value accepts any subject ID")
+	viperx.MustBindFlag(v, "subjects.id_format", flags.Lookup("subject-id-format"))
+
+	flags.Bool("auto-track-subjects", false, "start tracking unknown subjects when they're first assigned a role")
+	viperx.MustBindFlag(v, "subjects.auto_track", flags.Lookup("auto-track-subjects"))
+
+	flags.String("oidc-jwks-url", "",
+		"URL of the JWKS API bearer tokens are signed with. Requests aren't authenticated if no JWKS is set")
+	viperx.MustBindFlag(v, "oidc.jwks_url", flags.Lookup("oidc-jwks-url"))
+
+	flags.String("oidc-jwks-file", "",
+		"path to the JWKS API bearer tokens are signed with, instead of fetching it")
+	viperx.MustBindFlag(v, "oidc.jwks_file", flags.Lookup("oidc-jwks-file"))
+
+	flags.Duration("oidc-jwks-refresh-interval", httpsrv.DefaultJWKSRefreshInterval,
+		"how often to fetch the JWKS again")
+	viperx.MustBindFlag(v, "oidc.jwks_refresh_interval", flags.Lookup("oidc-jwks-refresh-interval"))
+
+	flags.String("oidc-issuer", "", "issuer API bearer tokens must come from")
+	viperx.MustBindFlag(v, "oidc.issuer", flags.Lookup("oidc-issuer"))
+
+	flags.String("oidc-audience", "", "audience API bearer tokens must be meant for")
+	viperx.MustBindFlag(v, "oidc.audience", flags.Lookup("oidc-audience"))
+
+	flags.String("oidc-subject-claim", httpsrv.DefaultSubjectClaim,
+		"claim of API bearer tokens holding the subject ID")
+	viperx.MustBindFlag(v, "oidc.subject_claim", flags.Lookup("oidc-subject-claim"))
+
+	flags.String("oidc-subject-prefix", "", "prefix prepended to the subject claim to make the subject ID")
+	viperx.MustBindFlag(v, "oidc.subject_prefix", flags.Lookup("oidc-subject-prefix"))
 }
 
 func serve(cmd *cobra.Command, args []string) error {
@@ -83,6 +156,41 @@ func serve(cmd *cobra.Command, args []string) error {
 	// Initialize app storage
 	appStore := appv1sql.New(dbconn)
 
+	routerOpts := []httpsrv.RouterOption{
+		httpsrv.WithIdempotencyRetention(v.GetDuration("idempotency.retention")),
+	}
+
+	if format := v.GetString("subjects.id_format"); format != "" {
+		re, err := regexp.Compile(format)
+		if err != nil {
+			return fmt.Errorf("failed to parse subject id format: %w", err)
+		}
+
+		routerOpts = append(routerOpts, httpsrv.WithSubjectIDFormat(re))
+	}
+
+	authenticated := false
+
+	if v.GetString("oidc.jwks_url") != "" || v.GetString("oidc.jwks_file") != "" {
+		auth, err := httpsrv.NewAuthenticator(httpsrv.AuthConfig{
+			JWKSURL:             v.GetString("oidc.jwks_url"),
+			JWKSFile:            v.GetString("oidc.jwks_file"),
+			JWKSRefreshInterval: v.GetDuration("oidc.jwks_refresh_interval"),
+			Issuer:              v.GetString("oidc.issuer"),
+			Audience:            v.GetString("oidc.audience"),
+			SubjectClaim:        v.GetString("oidc.subject_claim"),
+			SubjectPrefix:       v.GetString("oidc.subject_prefix"),
+		}, logger)
+		if err != nil {
+			return fmt.Errorf("failed to initialize authentication: %w", err)
+		}
+
+		routerOpts = append(routerOpts, httpsrv.WithAuthenticator(auth))
+		authenticated = true
+	} else {
+		logger.Warn("API authentication is disabled, set a JWKS URL or file to enable it")
+	}
+
 	// Initialize NATS connection
 	opts := []nats.Option{
 		nats.Name("lmi"),
@@ -100,6 +208,11 @@ func serve(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to connect to nats: %w", err)
 	}
 
+	// Initialize permissions storage
+	store := sqlstore.NewSQLDriver(dbconn,
+		sqlstore.WithAutoTrackSubjects(v.GetBool("subjects.auto_track")),
+	)
+
 	// Create NATS directory subscriber
 	watcher, err := cv1nats.NewSubscriber(natsconn, viper.GetString("nats.directories_subjects"))
 	if err != nil {
@@ -112,7 +225,7 @@ func serve(cmd *cobra.Command, args []string) error {
 	dirclient := clientv1.NewHTTPClient(nil)
 
 	// Initialize our reconciler
-	r := reconciler.NewReconciler()
+	r := reconciler.NewReconciler(store, logger)
 
 	// Get base directory
 	rawID := v.GetString("base_directory_id")
@@ -122,6 +235,17 @@ func serve(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to parse base directory id: %w", err)
 	}
 
+	// Keep LMI's own permissions in line with this version, so roles can
+	// grant them even before authorization is enabled.
+	if err := bootstrap.RegisterPermissions(cmd.Context(), store); err != nil {
+		return err
+	}
+
+	// The management API is only protected once callers are authenticated.
+	if authenticated {
+		routerOpts = append(routerOpts, httpsrv.WithAuthorization(baseDirID.String()))
+	}
+
 	ctrl, err := appv1.NewController(
 		apiv1.DirectoryID(baseDirID),
 		appv1.WithStorage(appStore),
@@ -133,15 +257,91 @@ func serve(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to create directory controller: %w", err)
 	}
 
-	ctx := cmd.Context()
+	ctx, cancel := context.WithCancel(cmd.Context())
+	defer cancel()
+
+	ctrlDone := make(chan struct{})
 
 	go func() {
-		if err := ctrl.Run(ctx); err != nil {
+		defer close(ctrlDone)
+
+		if err := ctrl.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
 			logger.Fatal("failed to run controller", zap.Error(err))
 		}
 	}()
 
+	// Sweep the permissions of expired role assignments
+	swp := sweeper.NewSweeper(store,
+		v.GetDuration("assignments.sweep_interval"),
+		logger,
+	)
+
+	swpDone := make(chan struct{})
+
+	go func() {
+		defer close(swpDone)
+
+		if err := swp.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			logger.Error("failed to run sweeper", zap.Error(err))
+		}
+	}()
+
+	// Prune the expired idempotency keys
+	pruner := sweeper.NewKeyPruner(store,
+		v.GetDuration("idempotency.prune_interval"),
+		logger,
+	)
+
+	prunerDone := make(chan struct{})
+
+	go func() {
+		defer close(prunerDone)
+
+		if err := pruner.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			logger.Error("failed to run idempotency key pruner", zap.Error(err))
+		}
+	}()
+
+	// Relay the events written to the outbox to NATS
+	relay := events.NewRelay(store, natsconn,
+		v.GetString("nats.permissions_subject"),
+		v.GetDuration("events.relay_interval"),
+		v.GetDuration("events.retention"),
+		logger,
+	)
+
+	relayDone := make(chan struct{})
+
+	go func() {
+		defer close(relayDone)
+
+		if err := relay.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			logger.Error("failed to run event relay", zap.Error(err))
+		}
+	}()
+
 	// Run permissions API server
+	srv := httpsrv.NewServer(logger, ginx.Config{
+		Listen: v.GetString("server.listen"),
+	}, store, routerOpts...)
+
+	// This blocks until we get a SIGINT or SIGTERM, at which point
+	// the HTTP listener is gracefully shut down.
+	srv.Run()
+
+	logger.Info("stopping directory controller, sweepers and event relay")
+
+	cancel()
+	<-ctrlDone
+	<-swpDone
+	<-prunerDone
+	<-relayDone
+
+	natsconn.Close()
+
+	if err := dbconn.Close(); err != nil {
+		return fmt.Errorf("failed to close db connection: %w", err)
+	}
 
 	return nil
 }
@@ -0,0 +1,99 @@
+// Package bootstrap seeds what LMI needs to protect its own API with its
+// own permissions.
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+	"github.com/infratographer/lmi/internal/storage"
+)
+
+// AdminRoleName is the name of the global role granting every one of
+// LMI's own permissions.
+const AdminRoleName = "lmi-admin"
+
+// RegisterPermissions registers LMI's own permissions, so that roles can
+// grant them.
+func RegisterPermissions(c context.Context, store storage.Storage) error {
+	if _, err := store.RegisterServicePermissions(c, apiv1.SelfService, apiv1.SelfPermissionManifest()); err != nil {
+		return fmt.Errorf("couldn't register LMI permissions: %w", err)
+	}
+
+	return nil
+}
+
+// SeedAdmin makes subject an admin of LMI on scope, which is normally the
+// base directory, by assigning it the admin role. The role, LMI's own
+// permissions and the subject are created as needed, so seeding an admin
+// again is harmless.
+func SeedAdmin(c context.Context, store storage.Storage, scope, subject string) (*apiv1.RoleInfo, error) {
+	if err := RegisterPermissions(c, store); err != nil {
+		return nil, err
+	}
+
+	role, err := adminRole(c, store)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = store.AddRolePermission(c, role.Id, apiv1.PermissionIdentifier{
+		Target: apiv1.ServiceTarget(apiv1.SelfService, apiv1.TargetWildcard),
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't grant LMI permissions to %s: %w", AdminRoleName, err)
+	}
+
+	if _, err := store.CreateSubject(c, apiv1.Subject{Id: subject}); err != nil && !errors.Is(err, storage.ErrAlreadyExists) {
+		return nil, fmt.Errorf("couldn't track subject %s: %w", subject, err)
+	}
+
+	err = store.AssignRole(c, role.Id, apiv1.NewRoleAssignment{
+		Subject: subject,
+		Scope:   scope,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't assign %s to %s: %w", AdminRoleName, subject, err)
+	}
+
+	return role, nil
+}
+
+// adminRole returns the admin role, creating it if needed.
+func adminRole(c context.Context, store storage.Storage) (*apiv1.RoleInfo, error) {
+	name := AdminRoleName
+
+	roles, err := store.GetRoles(c, &apiv1.GetRolesParams{Name: &name})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get roles: %w", err)
+	}
+
+	// roles with the same name may be defined in directories
+	for i, r := range roles.Items {
+		if r.Directory == nil {
+			return &roles.Items[i], nil
+		}
+	}
+
+	description := "Manages LMI"
+
+	r, err := store.CreateRole(c, apiv1.NewRole{
+		Name:        AdminRoleName,
+		Description: &description,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't create %s role: %w", AdminRoleName, err)
+	}
+
+	return &apiv1.RoleInfo{
+		Id:          r.Id,
+		Name:        r.Name,
+		Description: r.Description,
+		Directory:   r.Directory,
+		Version:     r.Version,
+		CreatedAt:   r.CreatedAt,
+		UpdatedAt:   r.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,69 @@
+package bootstrap_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/cockroachdb/cockroach-go/v2/testserver"
+	"github.com/google/uuid"
+	fsv1 "github.com/infratographer/fertilesoil/api/v1"
+	appv1sql "github.com/infratographer/fertilesoil/app/v1/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+	"github.com/infratographer/lmi/internal/bootstrap"
+	sqlstore "github.com/infratographer/lmi/internal/storage/sql"
+	"github.com/infratographer/lmi/internal/storage/sql/migrations"
+)
+
+const testAdmin = "urn:infratographer:user:6f1c1d35-4a0c-4d52-9c43-6a4f4a1e2b7d"
+
+func TestSeedAdmin(t *testing.T) {
+	t.Parallel()
+
+	ts, err := testserver.NewTestServer()
+	require.NoError(t, err)
+	t.Cleanup(ts.Stop)
+
+	db, err := sql.Open("postgres", ts.PGURL().String())
+	require.NoError(t, err, "failed to open db connection")
+
+	require.NoError(t, migrations.Migrate(db), "failed to run migrations")
+
+	ctx := context.Background()
+	store := sqlstore.NewSQLDriver(db)
+
+	// track the base directory the way the fertilesoil controller does
+	base := fsv1.DirectoryID(uuid.New())
+	_, err = appv1sql.New(db).CreateDirectory(ctx, &fsv1.Directory{Id: base})
+	require.NoError(t, err)
+	require.NoError(t, store.AddDirectory(ctx, base, nil))
+
+	role, err := bootstrap.SeedAdmin(ctx, store, base.String(), testAdmin)
+	require.NoError(t, err)
+	assert.Equal(t, bootstrap.AdminRoleName, role.Name)
+
+	res, err := store.CheckPermission(ctx, apiv1.CheckRequest{
+		Subject: testAdmin,
+		Target:  apiv1.TargetRolesCreate,
+		Scope:   base.String(),
+	})
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+
+	perms, err := store.GetPermissions(ctx, &apiv1.GetPermissionsParams{})
+	require.NoError(t, err)
+	assert.Len(t, perms.Items, len(apiv1.SelfPermissionManifest().Permissions)+1, "LMI permissions and the wildcard")
+
+	t.Run("seeding again is harmless", func(t *testing.T) {
+		again, err := bootstrap.SeedAdmin(ctx, store, base.String(), testAdmin)
+		require.NoError(t, err)
+		assert.Equal(t, role.Id, again.Id)
+
+		as, err := store.GetRoleAssignments(ctx, role.Id, nil)
+		require.NoError(t, err)
+		assert.Len(t, as.Items, 1)
+	})
+}
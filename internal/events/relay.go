@@ -0,0 +1,162 @@
+// Package events relays the changes made to LMI to NATS, so that the
+// services caching permissions know when to invalidate them.
+package events
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+	"github.com/infratographer/lmi/internal/storage"
+)
+
+const (
+	// batchSize is how many events are relayed per transaction.
+	batchSize = 100
+
+	// maxBackoff caps how long the relay waits before retrying an event
+	// that couldn't be published.
+	maxBackoff = time.Minute
+
+	// pruneInterval is how often delivered events are pruned.
+	pruneInterval = 10 * time.Minute
+)
+
+// Publisher publishes messages on a subject, e.g. a NATS connection.
+// Flush returns once the messages published so far reached the server.
+type Publisher interface {
+	Publish(subject string, data []byte) error
+	Flush() error
+}
+
+// Relay publishes the events written to the outbox, in order, each on a
+// subject made of a common prefix and the event type, e.g.
+// infratographer.events.permissions.roles.created. Events are delivered
+// at least once: an event may be published again if the relay stops
+// before it's marked delivered.
+type Relay struct {
+	store     storage.OutboxStorage
+	pub       Publisher
+	prefix    string
+	interval  time.Duration
+	retention time.Duration
+	logger    *zap.Logger
+}
+
+func NewRelay(
+	store storage.OutboxStorage,
+	pub Publisher,
+	prefix string,
+	interval time.Duration,
+	retention time.Duration,
+	logger *zap.Logger,
+) *Relay {
+	return &Relay{
+		store:     store,
+		pub:       pub,
+		prefix:    prefix,
+		interval:  interval,
+		retention: retention,
+		logger:    logger.Named("relay"),
+	}
+}
+
+// Subject returns the subject events of the given type are published on.
+func (r *Relay) Subject(t apiv1.EventType) string {
+	return r.prefix + "." + string(t)
+}
+
+// Run relays the pending events right away and then on every interval,
+// until the context is canceled. When an event can't be published, the
+// relay backs off exponentially before retrying it, without moving on to
+// the next events. Delivered events are pruned once past the retention.
+func (r *Relay) Run(ctx context.Context) error {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	backoff := r.interval
+
+	var lastPrune time.Time
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		if _, err := r.Relay(ctx); err != nil {
+			r.logger.Error("failed to relay events", zap.Error(err), zap.Duration("retry_in", backoff))
+
+			backoff *= 2
+			if backoff > maxBackoff {
+				backoff = maxBackoff
+			}
+		} else {
+			backoff = r.interval
+		}
+
+		if time.Since(lastPrune) >= pruneInterval {
+			r.prune(ctx)
+			lastPrune = time.Now()
+		}
+
+		timer.Reset(backoff)
+	}
+}
+
+// Relay publishes the pending events until the outbox is drained or an
+// event can't be published, and returns how many were delivered.
+func (r *Relay) Relay(ctx context.Context) (int, error) {
+	total := 0
+
+	for {
+		var pubErr error
+
+		n, err := r.store.RelayEvents(ctx, batchSize, func(evt storage.OutboxEvent) error {
+			pubErr = r.publish(evt)
+			return pubErr
+		})
+
+		total += n
+
+		if err != nil {
+			return total, err
+		}
+
+		if pubErr != nil {
+			return total, pubErr
+		}
+
+		if n < batchSize {
+			return total, nil
+		}
+	}
+}
+
+func (r *Relay) publish(evt storage.OutboxEvent) error {
+	if err := r.pub.Publish(r.Subject(evt.Type), evt.Payload); err != nil {
+		return fmt.Errorf("couldn't publish event %d: %w", evt.ID, err)
+	}
+
+	if err := r.pub.Flush(); err != nil {
+		return fmt.Errorf("couldn't publish event %d: %w", evt.ID, err)
+	}
+
+	return nil
+}
+
+func (r *Relay) prune(ctx context.Context) {
+	pruned, err := r.store.PruneEvents(ctx, time.Now().Add(-r.retention))
+	if err != nil {
+		r.logger.Error("failed to prune delivered events", zap.Error(err))
+		return
+	}
+
+	if pruned > 0 {
+		r.logger.Info("pruned delivered events", zap.Int64("events", pruned))
+	}
+}
@@ -0,0 +1,116 @@
+package events_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+	"github.com/infratographer/lmi/internal/events"
+	"github.com/infratographer/lmi/internal/storage"
+)
+
+// fakeOutbox relays its events like the SQL driver does: oldest first,
+// stopping at the first failure.
+type fakeOutbox struct {
+	pending []storage.OutboxEvent
+	pruned  []time.Time
+}
+
+func (o *fakeOutbox) RelayEvents(_ context.Context, limit int, deliver func(storage.OutboxEvent) error) (int, error) {
+	delivered := 0
+
+	for delivered < limit && delivered < len(o.pending) {
+		if err := deliver(o.pending[delivered]); err != nil {
+			o.pending[delivered].Attempts++
+			break
+		}
+
+		delivered++
+	}
+
+	o.pending = o.pending[delivered:]
+
+	return delivered, nil
+}
+
+func (o *fakeOutbox) PruneEvents(_ context.Context, before time.Time) (int64, error) {
+	o.pruned = append(o.pruned, before)
+	return 0, nil
+}
+
+type fakePublisher struct {
+	subjects []string
+	failOn   string
+}
+
+func (p *fakePublisher) Publish(subject string, _ []byte) error {
+	if subject == p.failOn {
+		return errors.New("nats is down")
+	}
+
+	p.subjects = append(p.subjects, subject)
+
+	return nil
+}
+
+func (p *fakePublisher) Flush() error {
+	return nil
+}
+
+func TestRelay(t *testing.T) {
+	t.Parallel()
+
+	outbox := &fakeOutbox{
+		pending: []storage.OutboxEvent{
+			{ID: 1, Type: apiv1.EventRoleCreated},
+			{ID: 2, Type: apiv1.EventRoleDeleted},
+			{ID: 3, Type: apiv1.EventAssignmentCreated},
+		},
+	}
+	pub := &fakePublisher{failOn: "infratographer.events.permissions.roles.deleted"}
+
+	r := events.NewRelay(outbox, pub, "infratographer.events.permissions", time.Second, time.Hour, zap.NewNop())
+
+	n, err := r.Relay(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, []string{"infratographer.events.permissions.roles.created"}, pub.subjects)
+
+	require.Len(t, outbox.pending, 2, "the failed event and the ones after it are kept")
+	assert.Equal(t, int64(2), outbox.pending[0].ID)
+	assert.Equal(t, int64(1), outbox.pending[0].Attempts)
+
+	t.Run("retries pick up where it stopped", func(t *testing.T) {
+		pub.failOn = ""
+
+		n, err := r.Relay(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 2, n)
+		assert.Equal(t, []string{
+			"infratographer.events.permissions.roles.created",
+			"infratographer.events.permissions.roles.deleted",
+			"infratographer.events.permissions.assignments.created",
+		}, pub.subjects)
+		assert.Empty(t, outbox.pending)
+	})
+}
+
+func TestRunPrunesAndStopsWithContext(t *testing.T) {
+	t.Parallel()
+
+	outbox := &fakeOutbox{}
+	r := events.NewRelay(outbox, &fakePublisher{}, "events", time.Millisecond, time.Hour, zap.NewNop())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	assert.ErrorIs(t, r.Run(ctx), context.DeadlineExceeded)
+	require.Len(t, outbox.pruned, 1, "prunes once per prune interval")
+	assert.WithinDuration(t, time.Now().Add(-time.Hour), outbox.pruned[0], time.Second)
+}
@@ -0,0 +1,127 @@
+package httpsrv_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach-go/v2/testserver"
+	"github.com/google/uuid"
+	fsv1 "github.com/infratographer/fertilesoil/api/v1"
+	appv1sql "github.com/infratographer/fertilesoil/app/v1/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+	sqlstore "github.com/infratographer/lmi/internal/storage/sql"
+	"github.com/infratographer/lmi/internal/storage/sql/migrations"
+)
+
+// TestServeAPI serves the API the way lmi serve does, backed by the SQL
+// driver, with requests validated against the spec.
+func TestServeAPI(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	ts, err := testserver.NewTestServer()
+	require.NoError(t, err)
+	t.Cleanup(ts.Stop)
+
+	db, err := sql.Open("postgres", ts.PGURL().String())
+	require.NoError(t, err, "failed to open db connection")
+
+	require.NoError(t, migrations.Migrate(db), "failed to run migrations")
+
+	store := sqlstore.NewSQLDriver(db, sqlstore.WithAutoTrackSubjects(true))
+
+	// the directory is tracked the way the reconciler does
+	scope := &fsv1.Directory{
+		Id:        fsv1.DirectoryID(uuid.New()),
+		Name:      "root",
+		CreatedAt: time.Now().UTC(),
+		UpdatedAt: time.Now().UTC(),
+	}
+
+	_, err = appv1sql.New(db).CreateDirectory(ctx, scope)
+	require.NoError(t, err)
+	require.NoError(t, store.AddDirectory(ctx, scope.Id, nil))
+
+	srv := newTestServer(t, store)
+	srv.serveAPI()
+
+	do := func(t *testing.T, method, path, body string, out interface{}) int {
+		t.Helper()
+
+		w := srv.do(method, "/api/v1"+path, body)
+
+		if out != nil && w.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), out))
+		}
+
+		return w.Code
+	}
+
+	subject := "urn:infratographer:user:" + uuid.NewString()
+	checkBody := `{"subject": "` + subject + `", "target": "instances.create", "scope": "` + scope.Id.String() + `"}`
+
+	role := &apiv1.Role{}
+
+	t.Run("roles are granted through the API", func(t *testing.T) {
+		status := do(t, http.MethodPost, "/permissions", `{"target": "instances.create", "description": "create instances"}`, nil)
+		require.Equal(t, http.StatusOK, status)
+
+		status = do(t, http.MethodPost, "/roles", `{"name": "instance-creator"}`, role)
+		require.Equal(t, http.StatusOK, status)
+
+		rolePath := "/roles/" + role.Id.String()
+
+		status = do(t, http.MethodPost, rolePath+"/permissions", `{"target": "instances.create"}`, nil)
+		require.Equal(t, http.StatusOK, status)
+
+		status = do(t, http.MethodPut, rolePath, `{"name": "instance-manager"}`, role)
+		require.Equal(t, http.StatusOK, status)
+		assert.Equal(t, "instance-manager", role.Name)
+
+		// full roles, as returned by the API, are still taken
+		update := *role
+		update.Name = "instance-admin"
+
+		body, err := json.Marshal(update)
+		require.NoError(t, err)
+
+		status = do(t, http.MethodPut, rolePath, string(body), role)
+		require.Equal(t, http.StatusOK, status)
+		assert.Equal(t, "instance-admin", role.Name)
+
+		status = do(t, http.MethodPost, rolePath+"/assignments",
+			`{"subject": "`+subject+`", "scope": "`+scope.Id.String()+`"}`, nil)
+		require.Equal(t, http.StatusOK, status)
+	})
+
+	t.Run("checks are answered", func(t *testing.T) {
+		res := &apiv1.CheckResult{}
+
+		status := do(t, http.MethodPost, "/check", checkBody, res)
+		require.Equal(t, http.StatusOK, status)
+		assert.True(t, res.Allowed)
+		assert.Equal(t, &role.Id, res.Role)
+
+		batch := &apiv1.BatchCheckResult{}
+
+		status = do(t, http.MethodPost, "/check/batch", `{"checks": [`+checkBody+`, {"subject": "`+subject+
+			`", "target": "instances.delete", "scope": "`+scope.Id.String()+`"}]}`, batch)
+		require.Equal(t, http.StatusOK, status)
+		require.Len(t, batch.Results, 2)
+		assert.True(t, batch.Results[0].Allowed)
+		assert.False(t, batch.Results[1].Allowed)
+	})
+
+	t.Run("requests are validated against the spec", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, do(t, http.MethodPost, "/roles", `{"description": "no name"}`, nil))
+		assert.Equal(t, http.StatusBadRequest, do(t, http.MethodPost, "/check", `{"subject": "`+subject+`"}`, nil))
+	})
+}
@@ -0,0 +1,119 @@
+package httpsrv_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+	"github.com/infratographer/lmi/internal/httpsrv"
+	"github.com/infratographer/lmi/internal/storage"
+)
+
+// batchStore fails the assignment changes on unknown roles.
+type batchStore struct {
+	*authzStore
+
+	atomic bool
+}
+
+func (s *batchStore) ChangeAssignments(_ context.Context, changes []apiv1.AssignmentChange, atomic bool) ([]error, error) {
+	s.atomic = atomic
+
+	errs := make([]error, len(changes))
+
+	for i, change := range changes {
+		if _, ok := s.roles[change.Role]; !ok {
+			errs[i] = fmt.Errorf("role %s: %w", change.Role, storage.ErrNotFound)
+		}
+	}
+
+	return errs, nil
+}
+
+func TestChangeAssignments(t *testing.T) {
+	t.Parallel()
+
+	const operator = "urn:infratographer:user:operator"
+
+	role := &apiv1.Role{Id: apiv1.EntityID(uuid.New()), Name: "viewer"}
+	unknown := uuid.NewString()
+
+	store := &batchStore{authzStore: newAuthzStore()}
+	store.roles[role.Id] = role
+
+	store.grant(operator, apiv1.TargetAssignmentsCreate, testSubScope)
+	store.grant(operator, apiv1.TargetAssignmentsDelete, testSubScope)
+
+	srv := newTestServer(t, store, httpsrv.WithAuthorization(testBaseScope))
+	srv.engine.POST("/assignments/batch", srv.rtr.ChangeAssignments)
+
+	change := func(op apiv1.AssignmentChangeOp, role, scope string) string {
+		return fmt.Sprintf(`{"op": %q, "role": %q, "subject": "urn:infratographer:user:someone", "scope": %q}`, op, role, scope)
+	}
+
+	do := func(body string) (int, apiv1.BatchAssignmentResult) {
+		w := srv.do(http.MethodPost, "/assignments/batch", body, testSubjectHeader, operator)
+
+		var res apiv1.BatchAssignmentResult
+		if w.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		}
+
+		return w.Code, res
+	}
+
+	changes := strings.Join([]string{
+		change(apiv1.Assign, role.Id.String(), testSubScope),
+		change(apiv1.Unassign, unknown, testSubScope),
+		change(apiv1.Unassign, role.Id.String(), testSubScope),
+	}, ",")
+
+	t.Run("partial", func(t *testing.T) {
+		status, res := do(`{"changes": [` + changes + `]}`)
+		require.Equal(t, http.StatusOK, status)
+		assert.False(t, store.atomic)
+		assert.True(t, res.Applied)
+
+		require.Len(t, res.Results, 3)
+		assert.Equal(t, apiv1.Applied, res.Results[0].Status)
+		assert.Nil(t, res.Results[0].Error)
+		assert.Equal(t, apiv1.Failed, res.Results[1].Status)
+		require.NotNil(t, res.Results[1].Error)
+		assert.Equal(t, apiv1.NotFound, res.Results[1].Error.Reason)
+		assert.Equal(t, int32(http.StatusNotFound), res.Results[1].Error.Code)
+		assert.Equal(t, apiv1.Applied, res.Results[2].Status)
+	})
+
+	t.Run("atomic", func(t *testing.T) {
+		status, res := do(`{"atomic": true, "changes": [` + changes + `]}`)
+		require.Equal(t, http.StatusOK, status)
+		assert.True(t, store.atomic)
+		assert.False(t, res.Applied)
+
+		require.Len(t, res.Results, 3)
+		assert.Equal(t, apiv1.RolledBack, res.Results[0].Status)
+		assert.Equal(t, apiv1.Failed, res.Results[1].Status)
+		assert.Equal(t, apiv1.RolledBack, res.Results[2].Status)
+	})
+
+	t.Run("every change must be allowed", func(t *testing.T) {
+		status, _ := do(`{"changes": [` + changes + `,` + change(apiv1.Assign, role.Id.String(), testBaseScope) + `]}`)
+		assert.Equal(t, http.StatusForbidden, status)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		status, _ := do(`{"changes": []}`)
+		assert.Equal(t, http.StatusBadRequest, status)
+
+		status, _ = do(`{"changes": [` + change("replace", role.Id.String(), testSubScope) + `]}`)
+		assert.Equal(t, http.StatusBadRequest, status)
+	})
+}
@@ -0,0 +1,242 @@
+package httpsrv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+	"go.uber.org/zap"
+
+	"github.com/infratographer/lmi/internal/storage"
+)
+
+var (
+	ErrInvalidAuthConfig = errors.New("invalid authentication config")
+
+	ErrMissingToken = errors.New("missing bearer token")
+
+	ErrInvalidToken = errors.New("invalid bearer token")
+)
+
+const (
+	// DefaultSubjectClaim is the claim subjects are taken from by default.
+	DefaultSubjectClaim = "sub"
+
+	// DefaultJWKSRefreshInterval is how often keys are fetched again by
+	// default.
+	DefaultJWKSRefreshInterval = time.Hour
+
+	// tokenLeeway is the clock skew allowed when checking when tokens
+	// were issued and expire.
+	tokenLeeway = time.Minute
+)
+
+// signingAlgorithms are the algorithms tokens may be signed with. Only
+// asymmetric ones are allowed, since keys are public.
+var signingAlgorithms = map[string]struct{}{
+	string(jose.RS256): {}, string(jose.RS384): {}, string(jose.RS512): {},
+	string(jose.PS256): {}, string(jose.PS384): {}, string(jose.PS512): {},
+	string(jose.ES256): {}, string(jose.ES384): {}, string(jose.ES512): {},
+	string(jose.EdDSA): {},
+}
+
+// AuthConfig configures how the bearer tokens authenticating API requests
+// are validated.
+type AuthConfig struct {
+	// JWKSURL is the URL of the JSON Web Key Set tokens are signed with.
+	JWKSURL string
+
+	// JWKSFile is the path to a file holding the JSON Web Key Set tokens
+	// are signed with, as an alternative to JWKSURL.
+	JWKSFile string
+
+	// JWKSRefreshInterval is how often the keys are fetched again from
+	// JWKSURL. They're also fetched when a token is signed with an
+	// unknown key.
+	JWKSRefreshInterval time.Duration
+
+	// Issuer is the issuer tokens must come from.
+	Issuer string
+
+	// Audience is the audience tokens must be meant for.
+	Audience string
+
+	// SubjectClaim is the claim holding the subject a token authenticates,
+	// DefaultSubjectClaim if empty.
+	SubjectClaim string
+
+	// SubjectPrefix is prepended to the subject claim to make the subject
+	// ID, e.g. urn:infratographer:user: for a claim holding a UUID.
+	SubjectPrefix string
+}
+
+// Authenticator validates bearer tokens and maps them to subjects.
+type Authenticator struct {
+	keys          *keySet
+	issuer        string
+	audience      string
+	subjectClaim  string
+	subjectPrefix string
+}
+
+// NewAuthenticator returns an authenticator validating tokens as
+// configured. A JWKS file is loaded right away.
+func NewAuthenticator(cfg AuthConfig, logger *zap.Logger) (*Authenticator, error) {
+	if cfg.Issuer == "" || cfg.Audience == "" {
+		return nil, fmt.Errorf("%w: issuer and audience are required", ErrInvalidAuthConfig)
+	}
+
+	a := &Authenticator{
+		issuer:        cfg.Issuer,
+		audience:      cfg.Audience,
+		subjectClaim:  cfg.SubjectClaim,
+		subjectPrefix: cfg.SubjectPrefix,
+	}
+
+	if a.subjectClaim == "" {
+		a.subjectClaim = DefaultSubjectClaim
+	}
+
+	switch {
+	case cfg.JWKSURL != "" && cfg.JWKSFile != "":
+		return nil, fmt.Errorf("%w: only one of a JWKS URL or file may be given", ErrInvalidAuthConfig)
+	case cfg.JWKSURL != "":
+		refresh := cfg.JWKSRefreshInterval
+		if refresh <= 0 {
+			refresh = DefaultJWKSRefreshInterval
+		}
+
+		a.keys = remoteKeySet(cfg.JWKSURL, refresh, logger.Named("jwks"))
+	case cfg.JWKSFile != "":
+		keys, err := fileKeySet(cfg.JWKSFile, logger.Named("jwks"))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidAuthConfig, err)
+		}
+
+		a.keys = keys
+	default:
+		return nil, fmt.Errorf("%w: a JWKS URL or file is required", ErrInvalidAuthConfig)
+	}
+
+	return a, nil
+}
+
+// Authenticate validates a bearer token and returns the ID of the subject
+// it authenticates. ErrInvalidToken is returned for tokens that aren't
+// valid, and other errors if the keys couldn't be loaded.
+func (a *Authenticator) Authenticate(c context.Context, token string) (string, error) {
+	tok, err := jwt.ParseSigned(token)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrInvalidToken, err)
+	}
+
+	// tokens are signed once, with a JWS compact serialization
+	header := tok.Headers[0]
+
+	if _, ok := signingAlgorithms[header.Algorithm]; !ok {
+		return "", fmt.Errorf("%w: unsupported algorithm %s", ErrInvalidToken, header.Algorithm)
+	}
+
+	keys, err := a.keys.lookup(c, header.KeyID)
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		claims jwt.Claims
+		extra  map[string]interface{}
+	)
+
+	verified := false
+
+	for _, key := range keys {
+		if key.Algorithm != "" && key.Algorithm != header.Algorithm {
+			continue
+		}
+
+		if err := tok.Claims(key.Key, &claims, &extra); err == nil {
+			verified = true
+			break
+		}
+	}
+
+	if !verified {
+		return "", fmt.Errorf("%w: signature doesn't match any key", ErrInvalidToken)
+	}
+
+	if claims.Expiry == nil {
+		return "", fmt.Errorf("%w: token doesn't expire", ErrInvalidToken)
+	}
+
+	err = claims.ValidateWithLeeway(jwt.Expected{
+		Issuer:   a.issuer,
+		Audience: jwt.Audience{a.audience},
+		Time:     time.Now(),
+	}, tokenLeeway)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrInvalidToken, err)
+	}
+
+	subject, ok := extra[a.subjectClaim].(string)
+	if !ok || subject == "" {
+		return "", fmt.Errorf("%w: missing %s claim", ErrInvalidToken, a.subjectClaim)
+	}
+
+	return a.subjectPrefix + subject, nil
+}
+
+// WithAuthenticator makes the router require API requests to carry a
+// bearer token validated by the given authenticator.
+func WithAuthenticator(auth *Authenticator) RouterOption {
+	return func(rtr *Router) {
+		rtr.auth = auth
+	}
+}
+
+// Authenticate is the middleware authenticating API requests. The subject
+// authenticated by the request's bearer token is set as the actor of the
+// request's context, so it can be read with storage.ActorFromContext.
+func (rtr *Router) Authenticate(c *gin.Context) {
+	token, ok := bearerToken(c.GetHeader("Authorization"))
+	if !ok {
+		c.Header("WWW-Authenticate", "Bearer")
+		rtr.ErrorHandler(c, ErrMissingToken, http.StatusUnauthorized)
+		c.Abort()
+
+		return
+	}
+
+	subject, err := rtr.auth.Authenticate(c, token)
+	if err != nil {
+		if errors.Is(err, ErrInvalidToken) {
+			c.Header("WWW-Authenticate", `Bearer error="invalid_token"`)
+			rtr.ErrorHandler(c, err, http.StatusUnauthorized)
+		} else {
+			rtr.ErrorHandler(c, err, http.StatusInternalServerError)
+		}
+
+		c.Abort()
+
+		return
+	}
+
+	c.Set(storage.ActorKey, subject)
+	c.Request = c.Request.WithContext(storage.WithActor(c.Request.Context(), subject))
+
+	c.Next()
+}
+
+func bearerToken(header string) (string, bool) {
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return "", false
+	}
+
+	return strings.TrimSpace(token), true
+}
@@ -0,0 +1,386 @@
+package httpsrv_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/infratographer/lmi/internal/httpsrv"
+	"github.com/infratographer/lmi/internal/storage"
+)
+
+const (
+	testIssuer   = "https://issuer.example.com/"
+	testAudience = "lmi"
+)
+
+type testKey struct {
+	kid  string
+	priv *ecdsa.PrivateKey
+}
+
+func newTestKey(t *testing.T, kid string) *testKey {
+	t.Helper()
+
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	return &testKey{kid: kid, priv: priv}
+}
+
+func (k *testKey) jwk() jose.JSONWebKey {
+	return jose.JSONWebKey{
+		Key:       k.priv.Public(),
+		KeyID:     k.kid,
+		Algorithm: string(jose.ES256),
+		Use:       "sig",
+	}
+}
+
+// sign returns a token signed with the key, with the given claims on top
+// of valid defaults.
+func (k *testKey) sign(t *testing.T, claims map[string]interface{}) string {
+	t.Helper()
+
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.ES256, Key: k.priv},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader(jose.HeaderKey("kid"), k.kid),
+	)
+	require.NoError(t, err)
+
+	now := time.Now()
+	all := map[string]interface{}{
+		"iss": testIssuer,
+		"aud": testAudience,
+		"sub": "3f8f0c3a-5fa2-4e2b-a0a1-1c4c3b8a2f6e",
+		"iat": now.Unix(),
+		"exp": now.Add(time.Hour).Unix(),
+	}
+
+	for name, value := range claims {
+		if value == nil {
+			delete(all, name)
+		} else {
+			all[name] = value
+		}
+	}
+
+	token, err := jwt.Signed(signer).Claims(all).CompactSerialize()
+	require.NoError(t, err)
+
+	return token
+}
+
+// jwksServer serves the public keys of the given keys, which may be
+// changed, and counts how many times they're fetched.
+type jwksServer struct {
+	*httptest.Server
+
+	keys    atomic.Value
+	fetches atomic.Int64
+
+	// failing makes fetches fail, as if the issuer was down.
+	failing atomic.Bool
+}
+
+func newJWKSServer(t *testing.T, keys ...*testKey) *jwksServer {
+	t.Helper()
+
+	s := &jwksServer{}
+	s.setKeys(keys...)
+
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.fetches.Add(1)
+
+		if s.failing.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(s.keys.Load())
+	}))
+
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func (s *jwksServer) setKeys(keys ...*testKey) {
+	ks := jose.JSONWebKeySet{}
+	for _, k := range keys {
+		ks.Keys = append(ks.Keys, k.jwk())
+	}
+
+	s.keys.Store(ks)
+}
+
+// whoami serves the subject authenticated by requests.
+func whoami(t *testing.T, auth *httpsrv.Authenticator) *testServer {
+	t.Helper()
+
+	srv := newTestServer(t, nil, httpsrv.WithAuthenticator(auth))
+	srv.engine.GET("/whoami", srv.rtr.Authenticate, func(c *gin.Context) {
+		c.String(http.StatusOK, storage.ActorFromContext(c))
+	})
+
+	return srv
+}
+
+func get(srv *testServer, token string) *httptest.ResponseRecorder {
+	if token != "" {
+		token = "Bearer " + token
+	}
+
+	return srv.do(http.MethodGet, "/whoami", "", "Authorization", token)
+}
+
+func TestAuthenticate(t *testing.T) {
+	t.Parallel()
+
+	key := newTestKey(t, "key-1")
+	jwks := newJWKSServer(t, key)
+
+	auth, err := httpsrv.NewAuthenticator(httpsrv.AuthConfig{
+		JWKSURL:       jwks.URL,
+		Issuer:        testIssuer,
+		Audience:      testAudience,
+		SubjectPrefix: "urn:infratographer:user:",
+	}, zap.NewNop())
+	require.NoError(t, err)
+
+	srv := whoami(t, auth)
+
+	t.Run("valid token", func(t *testing.T) {
+		t.Parallel()
+
+		w := get(srv, key.sign(t, nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "urn:infratographer:user:3f8f0c3a-5fa2-4e2b-a0a1-1c4c3b8a2f6e", w.Body.String())
+	})
+
+	t.Run("missing token", func(t *testing.T) {
+		t.Parallel()
+
+		w := get(srv, "")
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Equal(t, "Bearer", w.Header().Get("WWW-Authenticate"))
+	})
+
+	invalid := []struct {
+		name  string
+		token string
+	}{
+		{name: "malformed", token: "not-a-jwt"},
+		{name: "wrong issuer", token: key.sign(t, map[string]interface{}{"iss": "https://evil.example.com/"})},
+		{name: "wrong audience", token: key.sign(t, map[string]interface{}{"aud": "other"})},
+		{name: "expired", token: key.sign(t, map[string]interface{}{"exp": time.Now().Add(-time.Hour).Unix()})},
+		{name: "no expiry", token: key.sign(t, map[string]interface{}{"exp": nil})},
+		{name: "not yet valid", token: key.sign(t, map[string]interface{}{"nbf": time.Now().Add(time.Hour).Unix()})},
+		{name: "no subject", token: key.sign(t, map[string]interface{}{"sub": nil})},
+		{name: "unknown key", token: newTestKey(t, "key-1").sign(t, nil)},
+	}
+
+	for _, tc := range invalid {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			w := get(srv, tc.token)
+			assert.Equal(t, http.StatusUnauthorized, w.Code)
+			assert.Equal(t, `Bearer error="invalid_token"`, w.Header().Get("WWW-Authenticate"))
+		})
+	}
+}
+
+func TestAuthenticateSubjectClaim(t *testing.T) {
+	t.Parallel()
+
+	key := newTestKey(t, "key-1")
+
+	auth, err := httpsrv.NewAuthenticator(httpsrv.AuthConfig{
+		JWKSURL:      newJWKSServer(t, key).URL,
+		Issuer:       testIssuer,
+		Audience:     testAudience,
+		SubjectClaim: "client_id",
+	}, zap.NewNop())
+	require.NoError(t, err)
+
+	srv := whoami(t, auth)
+
+	w := get(srv, key.sign(t, map[string]interface{}{"client_id": "urn:infratographer:client:1234"}))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "urn:infratographer:client:1234", w.Body.String())
+
+	w = get(srv, key.sign(t, nil))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestAuthenticateKeyRotation(t *testing.T) {
+	t.Parallel()
+
+	oldKey := newTestKey(t, "old")
+	newKey := newTestKey(t, "new")
+	jwks := newJWKSServer(t, oldKey)
+
+	auth, err := httpsrv.NewAuthenticator(httpsrv.AuthConfig{
+		JWKSURL:  jwks.URL,
+		Issuer:   testIssuer,
+		Audience: testAudience,
+	}, zap.NewNop())
+	require.NoError(t, err)
+
+	srv := whoami(t, auth)
+
+	assert.Equal(t, http.StatusOK, get(srv, oldKey.sign(t, nil)).Code)
+	assert.Equal(t, http.StatusOK, get(srv, oldKey.sign(t, nil)).Code)
+	assert.Equal(t, int64(1), jwks.fetches.Load(), "keys are cached")
+
+	// Keys aren't fetched again right away for unknown keys, so that
+	// bogus tokens can't make us hammer the issuer.
+	jwks.setKeys(oldKey, newKey)
+
+	assert.Equal(t, http.StatusUnauthorized, get(srv, newKey.sign(t, nil)).Code)
+	assert.Equal(t, int64(1), jwks.fetches.Load())
+}
+
+func TestAuthenticateJWKSUnavailable(t *testing.T) {
+	t.Parallel()
+
+	key := newTestKey(t, "key-1")
+	jwks := newJWKSServer(t, key)
+
+	auth, err := httpsrv.NewAuthenticator(httpsrv.AuthConfig{
+		JWKSURL:             jwks.URL,
+		JWKSRefreshInterval: time.Millisecond,
+		Issuer:              testIssuer,
+		Audience:            testAudience,
+	}, zap.NewNop())
+	require.NoError(t, err)
+
+	srv := whoami(t, auth)
+
+	assert.Equal(t, http.StatusOK, get(srv, key.sign(t, nil)).Code)
+
+	// Once loaded, keys are kept when the issuer can't be reached, and
+	// failed refreshes aren't retried right away.
+	jwks.failing.Store(true)
+	time.Sleep(10 * time.Millisecond)
+
+	fetches := jwks.fetches.Load()
+
+	assert.Equal(t, http.StatusOK, get(srv, key.sign(t, nil)).Code)
+	assert.Equal(t, http.StatusOK, get(srv, key.sign(t, nil)).Code)
+	assert.Equal(t, http.StatusUnauthorized, get(srv, newTestKey(t, "key-2").sign(t, nil)).Code)
+	assert.Equal(t, fetches+1, jwks.fetches.Load())
+}
+
+func TestAuthenticateJWKSUnavailableAtStart(t *testing.T) {
+	t.Parallel()
+
+	key := newTestKey(t, "key-1")
+	jwks := newJWKSServer(t, key)
+	jwks.failing.Store(true)
+
+	auth, err := httpsrv.NewAuthenticator(httpsrv.AuthConfig{
+		JWKSURL:  jwks.URL,
+		Issuer:   testIssuer,
+		Audience: testAudience,
+	}, zap.NewNop())
+	require.NoError(t, err)
+
+	srv := whoami(t, auth)
+
+	assert.Equal(t, http.StatusInternalServerError, get(srv, key.sign(t, nil)).Code)
+	assert.Equal(t, http.StatusInternalServerError, get(srv, key.sign(t, nil)).Code)
+	assert.Equal(t, int64(1), jwks.fetches.Load(), "failed fetches aren't retried right away")
+}
+
+func TestAuthenticateJWKSFile(t *testing.T) {
+	t.Parallel()
+
+	key := newTestKey(t, "key-1")
+
+	data, err := json.Marshal(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{key.jwk()}})
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+
+	auth, err := httpsrv.NewAuthenticator(httpsrv.AuthConfig{
+		JWKSFile: path,
+		Issuer:   testIssuer,
+		Audience: testAudience,
+	}, zap.NewNop())
+	require.NoError(t, err)
+
+	w := get(whoami(t, auth), key.sign(t, nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "3f8f0c3a-5fa2-4e2b-a0a1-1c4c3b8a2f6e", w.Body.String())
+}
+
+func TestNewAuthenticatorConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		cfg  httpsrv.AuthConfig
+	}{
+		{
+			name: "no JWKS",
+			cfg:  httpsrv.AuthConfig{Issuer: testIssuer, Audience: testAudience},
+		},
+		{
+			name: "JWKS URL and file",
+			cfg: httpsrv.AuthConfig{
+				JWKSURL:  "https://issuer.example.com/jwks.json",
+				JWKSFile: "jwks.json",
+				Issuer:   testIssuer,
+				Audience: testAudience,
+			},
+		},
+		{
+			name: "no issuer",
+			cfg:  httpsrv.AuthConfig{JWKSURL: "https://issuer.example.com/jwks.json", Audience: testAudience},
+		},
+		{
+			name: "no audience",
+			cfg:  httpsrv.AuthConfig{JWKSURL: "https://issuer.example.com/jwks.json", Issuer: testIssuer},
+		},
+		{
+			name: "missing JWKS file",
+			cfg: httpsrv.AuthConfig{
+				JWKSFile: filepath.Join(t.TempDir(), "missing.json"),
+				Issuer:   testIssuer,
+				Audience: testAudience,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := httpsrv.NewAuthenticator(tc.cfg, zap.NewNop())
+			assert.ErrorIs(t, err, httpsrv.ErrInvalidAuthConfig)
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package httpsrv
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+	"github.com/infratographer/lmi/internal/storage"
+)
+
+var ErrForbidden = errors.New("not allowed")
+
+// WithAuthorization makes the router check that callers are allowed to
+// use the management API by LMI's own permissions, as listed in
+// apiv1.SelfPermissionManifest. baseScope is the scope of the permissions
+// on objects that aren't defined in a directory, such as global roles.
+// It requires requests to be authenticated, see WithAuthenticator.
+func WithAuthorization(baseScope string) RouterOption {
+	return func(rtr *Router) {
+		rtr.authorization = true
+		rtr.baseScope = baseScope
+	}
+}
+
+// authorize checks whether the caller is allowed target on scope,
+// responding with an error if not.
+func (rtr *Router) authorize(c *gin.Context, target, scope string) bool {
+	if !rtr.authorization {
+		return true
+	}
+
+	subject := storage.ActorFromContext(c)
+	if subject == "" {
+		rtr.ErrorHandler(c, fmt.Errorf("%w: %s requires an authenticated subject", ErrForbidden, target), http.StatusForbidden)
+		return false
+	}
+
+	res, err := rtr.store.CheckPermission(c, apiv1.CheckRequest{
+		Subject: subject,
+		Target:  target,
+		Scope:   scope,
+	})
+	if err != nil {
+		rtr.ErrorChooser(c, err)
+		return false
+	}
+
+	if !res.Allowed {
+		rtr.ErrorHandler(c, fmt.Errorf("%w: %s on %s", ErrForbidden, target, scope), http.StatusForbidden)
+		return false
+	}
+
+	return true
+}
+
+// authorizeBase checks whether the caller is allowed target on the base
+// scope.
+func (rtr *Router) authorizeBase(c *gin.Context, target string) bool {
+	return rtr.authorize(c, target, rtr.baseScope)
+}
+
+// authorizeRole checks whether the caller is allowed target on the scope
+// of a role, which is the directory it's defined in or the base scope
+// for global roles.
+func (rtr *Router) authorizeRole(c *gin.Context, target string, id apiv1.EntityID) bool {
+	if !rtr.authorization {
+		return true
+	}
+
+	role, err := rtr.store.GetRole(c, id)
+	if err != nil {
+		rtr.ErrorChooser(c, err)
+		return false
+	}
+
+	return rtr.authorize(c, target, rtr.roleScope(role.Directory))
+}
+
+func (rtr *Router) roleScope(directory *string) string {
+	if directory == nil || *directory == "" {
+		return rtr.baseScope
+	}
+
+	return *directory
+}
+
+// authorizeDenyRule checks whether the caller is allowed target on the
+// scope of a deny rule.
+func (rtr *Router) authorizeDenyRule(c *gin.Context, target string, id apiv1.EntityID) bool {
+	if !rtr.authorization {
+		return true
+	}
+
+	rule, err := rtr.store.GetDenyRule(c, id)
+	if err != nil {
+		rtr.ErrorChooser(c, err)
+		return false
+	}
+
+	return rtr.authorize(c, target, rule.Scope)
+}
@@ -0,0 +1,198 @@
+package httpsrv_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+	"github.com/infratographer/lmi/internal/httpsrv"
+	"github.com/infratographer/lmi/internal/storage"
+)
+
+const (
+	testBaseScope = "2e4a3f6c-0b7d-4c1e-9f58-5d7a1b3c9e20"
+	testSubScope  = "8c1d2e3f-4a5b-4c6d-8e7f-9a0b1c2d3e4f"
+)
+
+// authzStore grants the permissions it's given and records the changes
+// that made it through.
+type authzStore struct {
+	storage.Storage
+
+	granted map[apiv1.CheckRequest]bool
+	roles   map[apiv1.EntityID]*apiv1.Role
+	changes []string
+}
+
+func newAuthzStore() *authzStore {
+	return &authzStore{
+		granted: map[apiv1.CheckRequest]bool{},
+		roles:   map[apiv1.EntityID]*apiv1.Role{},
+	}
+}
+
+func (s *authzStore) grant(subject, target, scope string) {
+	s.granted[apiv1.CheckRequest{Subject: subject, Target: target, Scope: scope}] = true
+}
+
+func (s *authzStore) CheckPermission(_ context.Context, req apiv1.CheckRequest) (*apiv1.CheckResult, error) {
+	return &apiv1.CheckResult{Allowed: s.granted[req]}, nil
+}
+
+func (s *authzStore) GetRole(_ context.Context, id apiv1.EntityID) (*apiv1.Role, error) {
+	r, ok := s.roles[id]
+	if !ok {
+		return nil, storage.ErrNotFound
+	}
+
+	return r, nil
+}
+
+func (s *authzStore) CreateRole(_ context.Context, role apiv1.NewRole) (*apiv1.Role, error) {
+	s.changes = append(s.changes, "create role "+role.Name)
+
+	return &apiv1.Role{
+		Id:        apiv1.EntityID(uuid.New()),
+		Name:      role.Name,
+		Directory: role.Directory,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}, nil
+}
+
+func (s *authzStore) DeleteRole(_ context.Context, id apiv1.EntityID, _ *int64) error {
+	s.changes = append(s.changes, "delete role "+s.roles[id].Name)
+	return nil
+}
+
+func (s *authzStore) AssignRole(_ context.Context, id apiv1.EntityID, a apiv1.NewRoleAssignment) error {
+	s.changes = append(s.changes, "assign "+s.roles[id].Name+" on "+a.Scope)
+	return nil
+}
+
+func TestAuthorization(t *testing.T) {
+	t.Parallel()
+
+	const (
+		admin    = "urn:infratographer:user:admin"
+		operator = "urn:infratographer:user:operator"
+	)
+
+	subScope := testSubScope
+
+	global := &apiv1.Role{Id: apiv1.EntityID(uuid.New()), Name: "global"}
+	scoped := &apiv1.Role{Id: apiv1.EntityID(uuid.New()), Name: "scoped", Directory: &subScope}
+
+	store := newAuthzStore()
+	store.roles[global.Id] = global
+	store.roles[scoped.Id] = scoped
+
+	store.grant(admin, apiv1.TargetRolesCreate, testBaseScope)
+	store.grant(admin, apiv1.TargetRolesDelete, testBaseScope)
+	store.grant(operator, apiv1.TargetRolesCreate, testSubScope)
+	store.grant(operator, apiv1.TargetRolesDelete, testSubScope)
+	store.grant(operator, apiv1.TargetAssignmentsCreate, testSubScope)
+
+	srv := newTestServer(t, store, httpsrv.WithAuthorization(testBaseScope))
+
+	rg := srv.engine.Group("/api/v1")
+	rg.POST("/roles", srv.rtr.CreateRole)
+	rg.DELETE("/roles/:id", srv.rtr.DeleteRole)
+	rg.POST("/roles/:id/assignments", srv.rtr.AssignRole)
+
+	tests := []struct {
+		name    string
+		subject string
+		method  string
+		path    string
+		body    string
+		status  int
+	}{
+		{
+			name:    "global role created on the base scope",
+			subject: admin,
+			method:  http.MethodPost,
+			path:    "/api/v1/roles",
+			body:    `{"name": "viewer"}`,
+			status:  http.StatusOK,
+		},
+		{
+			name:    "global role needs permission on the base scope",
+			subject: operator,
+			method:  http.MethodPost,
+			path:    "/api/v1/roles",
+			body:    `{"name": "viewer"}`,
+			status:  http.StatusForbidden,
+		},
+		{
+			name:    "scoped role created on its directory",
+			subject: operator,
+			method:  http.MethodPost,
+			path:    "/api/v1/roles",
+			body:    `{"name": "editor", "directory": "` + testSubScope + `"}`,
+			status:  http.StatusOK,
+		},
+		{
+			name:    "scoped role deleted on its directory",
+			subject: operator,
+			method:  http.MethodDelete,
+			path:    "/api/v1/roles/" + scoped.Id.String(),
+			status:  http.StatusOK,
+		},
+		{
+			name:    "global role deleted on the base scope",
+			subject: operator,
+			method:  http.MethodDelete,
+			path:    "/api/v1/roles/" + global.Id.String(),
+			status:  http.StatusForbidden,
+		},
+		{
+			name:    "unknown role",
+			subject: admin,
+			method:  http.MethodDelete,
+			path:    "/api/v1/roles/" + uuid.NewString(),
+			status:  http.StatusNotFound,
+		},
+		{
+			name:    "role assigned on the assignment scope",
+			subject: operator,
+			method:  http.MethodPost,
+			path:    "/api/v1/roles/" + global.Id.String() + "/assignments",
+			body:    `{"subject": "urn:infratographer:user:someone", "scope": "` + testSubScope + `"}`,
+			status:  http.StatusOK,
+		},
+		{
+			name:    "role assignment needs permission on the assignment scope",
+			subject: operator,
+			method:  http.MethodPost,
+			path:    "/api/v1/roles/" + global.Id.String() + "/assignments",
+			body:    `{"subject": "urn:infratographer:user:someone", "scope": "` + testBaseScope + `"}`,
+			status:  http.StatusForbidden,
+		},
+		{
+			name:   "unauthenticated",
+			method: http.MethodPost,
+			path:   "/api/v1/roles",
+			body:   `{"name": "viewer"}`,
+			status: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range tests {
+		w := srv.do(tc.method, tc.path, tc.body, testSubjectHeader, tc.subject)
+		assert.Equal(t, tc.status, w.Code, tc.name)
+	}
+
+	require.Equal(t, []string{
+		"create role viewer",
+		"create role editor",
+		"delete role scoped",
+		"assign global on " + testSubScope,
+	}, store.changes, "only authorized changes are made")
+}
@@ -0,0 +1,65 @@
+package httpsrv_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+	"github.com/infratographer/lmi/internal/storage"
+)
+
+// checkStore allows every check and counts the ones it answered.
+type checkStore struct {
+	storage.Storage
+
+	checked int
+}
+
+func (s *checkStore) CheckPermissions(_ context.Context, reqs []apiv1.CheckRequest) ([]apiv1.CheckResult, error) {
+	s.checked += len(reqs)
+
+	results := make([]apiv1.CheckResult, len(reqs))
+	for i := range results {
+		results[i].Allowed = true
+	}
+
+	return results, nil
+}
+
+func TestCheckPermissionsBatchSize(t *testing.T) {
+	t.Parallel()
+
+	store := &checkStore{}
+
+	srv := newTestServer(t, store)
+	srv.serveAPI()
+
+	check := `{"subject": "urn:infratographer:user:someone", "target": "instances.create", "scope": "` + uuid.NewString() + `"}`
+
+	batch := func(n int) string {
+		checks := make([]string, n)
+		for i := range checks {
+			checks[i] = check
+		}
+
+		return `{"checks": [` + strings.Join(checks, ",") + `]}`
+	}
+
+	w := srv.do(http.MethodPost, "/api/v1/check/batch", batch(100))
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	res := apiv1.BatchCheckResult{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+	assert.Len(t, res.Results, 100)
+
+	w = srv.do(http.MethodPost, "/api/v1/check/batch", batch(101))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, 100, store.checked, "oversized batches aren't checked")
+}
@@ -0,0 +1,102 @@
+package httpsrv_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+	"github.com/infratographer/lmi/internal/storage"
+)
+
+// failingStore fails to get roles with the given error.
+type failingStore struct {
+	storage.Storage
+
+	err error
+}
+
+func (s *failingStore) GetRole(context.Context, apiv1.EntityID) (*apiv1.Role, error) {
+	return nil, s.err
+}
+
+func TestErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		err     error
+		status  int
+		reason  apiv1.ErrorReason
+		message string
+	}{
+		{
+			name:    "not found",
+			err:     storage.ErrNotFound,
+			status:  http.StatusNotFound,
+			reason:  apiv1.NotFound,
+			message: "not found",
+		},
+		{
+			name:    "conflict",
+			err:     fmt.Errorf("role admin: %w", storage.ErrAlreadyExists),
+			status:  http.StatusConflict,
+			reason:  apiv1.Conflict,
+			message: "role admin: already exists",
+		},
+		{
+			name:    "invalid reference",
+			err:     storage.ErrSubjectNotTracked,
+			status:  http.StatusUnprocessableEntity,
+			reason:  apiv1.InvalidReference,
+			message: "subject is not tracked",
+		},
+		{
+			name:    "precondition failed",
+			err:     storage.NewError(storage.ErrPreconditionFailed, "role was changed"),
+			status:  http.StatusPreconditionFailed,
+			reason:  apiv1.PreconditionFailed,
+			message: "role was changed",
+		},
+		{
+			name:    "invalid argument",
+			err:     storage.ErrInvalidCursor,
+			status:  http.StatusBadRequest,
+			reason:  apiv1.InvalidArgument,
+			message: "invalid cursor",
+		},
+		{
+			name:    "internal",
+			err:     errors.New("pq: connection refused"),
+			status:  http.StatusInternalServerError,
+			reason:  apiv1.Internal,
+			message: "Internal Server Error",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := newTestServer(t, &failingStore{err: tc.err})
+			srv.engine.GET("/roles/:id", srv.rtr.GetRole)
+
+			w := srv.do(http.MethodGet, "/roles/"+uuid.NewString(), "")
+
+			var body apiv1.Error
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+
+			assert.Equal(t, tc.status, w.Code)
+			assert.Equal(t, apiv1.Error{Code: int32(tc.status), Reason: tc.reason, Message: tc.message}, body)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package httpsrv
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/infratographer/lmi/internal/storage"
+)
+
+const (
+	etagHeader    = "ETag"
+	ifMatchHeader = "If-Match"
+)
+
+// etag returns the ETag of a role version.
+func etag(version *int64) string {
+	if version == nil {
+		return ""
+	}
+
+	return strconv.Quote(strconv.FormatInt(*version, 10))
+}
+
+// setETag sets the ETag of the role version a response is about.
+func setETag(c *gin.Context, version *int64) {
+	if tag := etag(version); tag != "" {
+		c.Header(etagHeader, tag)
+	}
+}
+
+// ifMatch returns the role version a change is conditioned on by the
+// If-Match header, or nil for unconditional changes. A tag that can't be
+// a role version never matches. It aborts the request and returns false
+// if the header is malformed.
+func (rtr *Router) ifMatch(c *gin.Context) (*int64, bool) {
+	value := strings.TrimSpace(c.GetHeader(ifMatchHeader))
+	if value == "" || value == "*" {
+		return nil, true
+	}
+
+	if strings.Contains(value, ",") {
+		rtr.ErrorHandler(c, fmt.Errorf("If-Match only takes a single ETag: %s", value), http.StatusBadRequest)
+		return nil, false
+	}
+
+	// If-Match compares ETags strongly, so weak ones never match
+	if strings.HasPrefix(value, "W/") {
+		rtr.ErrorChooser(c, fmt.Errorf("%w: weak ETag %s", storage.ErrVersionMismatch, value))
+		return nil, false
+	}
+
+	tag, err := strconv.Unquote(value)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid If-Match ETag: %s", value), http.StatusBadRequest)
+		return nil, false
+	}
+
+	version, err := strconv.ParseInt(tag, 10, 64)
+	if err != nil {
+		rtr.ErrorChooser(c, fmt.Errorf("%w: %s isn't a role ETag", storage.ErrVersionMismatch, value))
+		return nil, false
+	}
+
+	return &version, true
+}
@@ -0,0 +1,128 @@
+package httpsrv_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+	"github.com/infratographer/lmi/internal/storage"
+)
+
+// versionStore keeps a single role and bumps its version on every change.
+type versionStore struct {
+	storage.Storage
+
+	role *apiv1.Role
+}
+
+func (s *versionStore) change(ifVersion *int64) (int64, error) {
+	if ifVersion != nil && *ifVersion != *s.role.Version {
+		return 0, storage.ErrVersionMismatch
+	}
+
+	version := *s.role.Version + 1
+	s.role.Version = &version
+
+	return version, nil
+}
+
+func (s *versionStore) GetRole(context.Context, apiv1.EntityID) (*apiv1.Role, error) {
+	return s.role, nil
+}
+
+func (s *versionStore) UpdateRole(_ context.Context, role *apiv1.Role, ifVersion *int64) (*apiv1.Role, error) {
+	if _, err := s.change(ifVersion); err != nil {
+		return nil, err
+	}
+
+	s.role.Name = role.Name
+
+	return s.role, nil
+}
+
+func (s *versionStore) AddRolePermission(
+	_ context.Context,
+	_ apiv1.EntityID,
+	_ apiv1.PermissionIdentifier,
+	ifVersion *int64,
+) (int64, error) {
+	return s.change(ifVersion)
+}
+
+func (s *versionStore) AddRoleInclude(
+	_ context.Context,
+	_ apiv1.EntityID,
+	_ apiv1.RoleIdentifier,
+	ifVersion *int64,
+) (int64, error) {
+	return s.change(ifVersion)
+}
+
+func TestETags(t *testing.T) {
+	t.Parallel()
+
+	version := int64(1)
+	id := apiv1.EntityID(uuid.New())
+	store := &versionStore{role: &apiv1.Role{Id: id, Name: "viewer", Version: &version}}
+
+	srv := newTestServer(t, store)
+	srv.engine.GET("/roles/:id", srv.rtr.GetRole)
+	srv.engine.PUT("/roles/:id", srv.rtr.UpdateRole)
+	srv.engine.POST("/roles/:id/permissions", srv.rtr.AddRolePermission)
+	srv.engine.POST("/roles/:id/includes", srv.rtr.AddRoleInclude)
+
+	do := func(method, path, ifMatch, body string) *httptest.ResponseRecorder {
+		return srv.do(method, path, body, "If-Match", ifMatch)
+	}
+
+	rolePath := "/roles/" + id.String()
+	permissionsPath := rolePath + "/permissions"
+	update := `{"id": "` + id.String() + `", "name": "editor"}`
+	includesPath := rolePath + "/includes"
+	permission := `{"target": "lmi:roles:get"}`
+	include := `{"id": "` + uuid.NewString() + `"}`
+
+	w := do(http.MethodGet, rolePath, "", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `"1"`, w.Header().Get("ETag"))
+
+	w = do(http.MethodPut, rolePath, `"1"`, update)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `"2"`, w.Header().Get("ETag"))
+
+	w = do(http.MethodPut, rolePath, `"1"`, update)
+	assert.Equal(t, http.StatusPreconditionFailed, w.Code, "stale ETag")
+
+	w = do(http.MethodPost, permissionsPath, `"1"`, permission)
+	assert.Equal(t, http.StatusPreconditionFailed, w.Code, "stale ETag")
+
+	w = do(http.MethodPost, permissionsPath, `"2"`, permission)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `"3"`, w.Header().Get("ETag"))
+
+	w = do(http.MethodPost, permissionsPath, `W/"3"`, permission)
+	assert.Equal(t, http.StatusPreconditionFailed, w.Code, "weak ETags never match")
+
+	w = do(http.MethodPost, permissionsPath, `"3", "4"`, permission)
+	assert.Equal(t, http.StatusBadRequest, w.Code, "several ETags")
+
+	w = do(http.MethodPut, rolePath, "*", update)
+	assert.Equal(t, http.StatusOK, w.Code, "any version")
+	assert.Equal(t, `"4"`, w.Header().Get("ETag"))
+
+	w = do(http.MethodPut, rolePath, "", update)
+	assert.Equal(t, http.StatusOK, w.Code, "unconditional")
+	assert.Equal(t, `"5"`, w.Header().Get("ETag"))
+
+	w = do(http.MethodPost, includesPath, `"4"`, include)
+	assert.Equal(t, http.StatusPreconditionFailed, w.Code, "stale ETag")
+
+	w = do(http.MethodPost, includesPath, `"5"`, include)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `"6"`, w.Header().Get("ETag"))
+}
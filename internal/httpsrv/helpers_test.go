@@ -0,0 +1,66 @@
+package httpsrv_test
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/infratographer/lmi/internal/httpsrv"
+	"github.com/infratographer/lmi/internal/storage"
+)
+
+// testSubjectHeader stands in for authentication: requests are made on
+// behalf of the subject it holds.
+const testSubjectHeader = "X-Test-Subject"
+
+// testServer serves the handlers under test, which are registered on its
+// engine, backed by a fake store.
+type testServer struct {
+	rtr    *httpsrv.Router
+	engine *gin.Engine
+}
+
+func newTestServer(t *testing.T, store storage.Storage, opts ...httpsrv.RouterOption) *testServer {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+
+	engine := gin.New()
+
+	engine.Use(func(c *gin.Context) {
+		if subject := c.GetHeader(testSubjectHeader); subject != "" {
+			c.Set(storage.ActorKey, subject)
+		}
+	})
+
+	return &testServer{
+		rtr:    httpsrv.NewRouter(store, opts...),
+		engine: engine,
+	}
+}
+
+// serveAPI registers the API routes, which validate requests against the
+// spec, the way lmi serve does.
+func (s *testServer) serveAPI() {
+	s.rtr.Routes(s.engine.Group("/"))
+}
+
+// do makes a request with a JSON body, along with the given headers as
+// name and value pairs. Headers with empty values are left out.
+func (s *testServer) do(method, path, body string, header ...string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	for i := 0; i+1 < len(header); i += 2 {
+		if header[i+1] != "" {
+			req.Header.Set(header[i], header[i+1])
+		}
+	}
+
+	w := httptest.NewRecorder()
+	s.engine.ServeHTTP(w, req)
+
+	return w
+}
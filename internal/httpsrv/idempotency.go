@@ -0,0 +1,219 @@
+package httpsrv
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/infratographer/lmi/internal/storage"
+)
+
+const (
+	// DefaultIdempotencyRetention is how long idempotency keys are kept by
+	// default.
+	DefaultIdempotencyRetention = 24 * time.Hour
+
+	idempotencyKeyHeader      = "Idempotency-Key"
+	idempotentReplayedHeader  = "Idempotent-Replayed"
+	maxIdempotencyKeyLength   = 255
+	idempotentResponseTimeout = 10 * time.Second
+)
+
+var (
+	// ErrIdempotencyKeyReused is returned for requests made with the key
+	// of another request.
+	ErrIdempotencyKeyReused = errors.New("idempotency key was used for another request")
+
+	// ErrIdempotentRequestInProgress is returned for requests retried
+	// while the original request is still being processed.
+	ErrIdempotentRequestInProgress = errors.New("request with this idempotency key is still being processed")
+)
+
+// replayedHeaders are the response headers returned again when a request
+// is retried.
+var replayedHeaders = []string{"Content-Type", etagHeader}
+
+// WithIdempotencyRetention sets how long idempotency keys are kept, and
+// their responses returned to retried requests.
+func WithIdempotencyRetention(retention time.Duration) RouterOption {
+	return func(rtr *Router) {
+		rtr.idempotencyRetention = retention
+	}
+}
+
+// Idempotent is the middleware making changes safe to retry. The response
+// to a POST, PUT or DELETE request made with an Idempotency-Key header is
+// stored along with the key, and returned again when the request is made
+// again with the same key instead of applying it again. Server errors
+// aren't stored, so those requests can be retried.
+func (rtr *Router) Idempotent(c *gin.Context) {
+	switch c.Request.Method {
+	case http.MethodPost, http.MethodPut, http.MethodDelete:
+	default:
+		c.Next()
+		return
+	}
+
+	value := c.GetHeader(idempotencyKeyHeader)
+	if value == "" {
+		c.Next()
+		return
+	}
+
+	if len(value) > maxIdempotencyKeyLength {
+		rtr.ErrorHandler(c, fmt.Errorf("%s is longer than %d characters", idempotencyKeyHeader, maxIdempotencyKeyLength),
+			http.StatusBadRequest)
+
+		return
+	}
+
+	fingerprint, err := requestFingerprint(c.Request)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("couldn't read request: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	key := storage.IdempotencyKey{
+		Actor: storage.ActorFromContext(c),
+		Key:   value,
+	}
+
+	prev, err := rtr.store.ReserveIdempotencyKey(c, key, fingerprint, time.Now().Add(rtr.idempotencyRetention))
+	if err != nil {
+		rtr.ErrorChooser(c, err)
+		return
+	}
+
+	if prev != nil {
+		rtr.replay(c, prev, fingerprint)
+		return
+	}
+
+	w := &recordingWriter{ResponseWriter: c.Writer}
+	c.Writer = w
+
+	// The key is released if a handler panics, so that the request can
+	// be retried rather than being stuck in progress until it expires.
+	defer func() {
+		if r := recover(); r != nil {
+			rtr.releaseIdempotencyKey(c, key)
+			panic(r)
+		}
+
+		rtr.saveIdempotentResponse(c, key, w)
+	}()
+
+	c.Next()
+}
+
+// saveIdempotentResponse saves the response to a request made with an
+// idempotency key, or releases the key for server errors.
+func (rtr *Router) saveIdempotentResponse(c *gin.Context, key storage.IdempotencyKey, w *recordingWriter) {
+	status := w.Status()
+
+	if status >= http.StatusInternalServerError {
+		rtr.releaseIdempotencyKey(c, key)
+		return
+	}
+
+	resp := storage.IdempotentResponse{
+		Status: status,
+		Header: map[string]string{},
+		Body:   w.body.Bytes(),
+	}
+
+	for _, name := range replayedHeaders {
+		if value := w.Header().Get(name); value != "" {
+			resp.Header[name] = value
+		}
+	}
+
+	// The response is saved even if the client went away, since that's
+	// when it retries.
+	ctx, cancel := context.WithTimeout(context.Background(), idempotentResponseTimeout)
+	defer cancel()
+
+	if err := rtr.store.SaveIdempotentResponse(ctx, key, resp); err != nil {
+		_ = c.Error(fmt.Errorf("couldn't save idempotent response: %w", err))
+	}
+}
+
+// releaseIdempotencyKey releases the key of a request that failed, so
+// that it can be retried.
+func (rtr *Router) releaseIdempotencyKey(c *gin.Context, key storage.IdempotencyKey) {
+	ctx, cancel := context.WithTimeout(context.Background(), idempotentResponseTimeout)
+	defer cancel()
+
+	if err := rtr.store.ReleaseIdempotencyKey(ctx, key); err != nil {
+		_ = c.Error(fmt.Errorf("couldn't release idempotency key: %w", err))
+	}
+}
+
+// replay responds to a retried request with the original response.
+func (rtr *Router) replay(c *gin.Context, prev *storage.IdempotentRequest, fingerprint string) {
+	if prev.Fingerprint != fingerprint {
+		rtr.ErrorHandler(c, ErrIdempotencyKeyReused, http.StatusConflict)
+		return
+	}
+
+	if prev.Response == nil {
+		rtr.ErrorHandler(c, ErrIdempotentRequestInProgress, http.StatusConflict)
+		return
+	}
+
+	for name, value := range prev.Response.Header {
+		c.Header(name, value)
+	}
+
+	c.Header(idempotentReplayedHeader, "true")
+	c.Status(prev.Response.Status)
+
+	if len(prev.Response.Body) > 0 {
+		_, _ = c.Writer.Write(prev.Response.Body)
+	}
+
+	c.Abort()
+}
+
+// requestFingerprint returns a digest of the method, URL, If-Match header
+// and body of a request, leaving the body to be read again. A request made
+// again with a fresh ETag is a different request.
+func requestFingerprint(req *http.Request) (string, error) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return "", err
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	h := sha256.New()
+	fmt.Fprintf(h, "%s %s\n%s: %s\n", req.Method, req.URL.RequestURI(), ifMatchHeader, req.Header.Get(ifMatchHeader))
+	h.Write(body)
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// recordingWriter keeps a copy of the response body.
+type recordingWriter struct {
+	gin.ResponseWriter
+
+	body bytes.Buffer
+}
+
+func (w *recordingWriter) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *recordingWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
@@ -0,0 +1,177 @@
+package httpsrv_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+	"github.com/infratographer/lmi/internal/storage"
+)
+
+// idempotencyStore keeps idempotency keys in memory, and creates roles
+// unless failing or panicking.
+type idempotencyStore struct {
+	storage.Storage
+
+	keys      map[storage.IdempotencyKey]*storage.IdempotentRequest
+	created   int
+	failing   bool
+	panicking bool
+}
+
+func (s *idempotencyStore) ReserveIdempotencyKey(
+	_ context.Context,
+	key storage.IdempotencyKey,
+	fingerprint string,
+	_ time.Time,
+) (*storage.IdempotentRequest, error) {
+	if req, ok := s.keys[key]; ok {
+		return req, nil
+	}
+
+	s.keys[key] = &storage.IdempotentRequest{Fingerprint: fingerprint}
+
+	return nil, nil
+}
+
+func (s *idempotencyStore) SaveIdempotentResponse(
+	_ context.Context,
+	key storage.IdempotencyKey,
+	resp storage.IdempotentResponse,
+) error {
+	s.keys[key].Response = &resp
+	return nil
+}
+
+func (s *idempotencyStore) ReleaseIdempotencyKey(_ context.Context, key storage.IdempotencyKey) error {
+	delete(s.keys, key)
+	return nil
+}
+
+func (s *idempotencyStore) CreateRole(_ context.Context, role apiv1.NewRole) (*apiv1.Role, error) {
+	if s.failing {
+		return nil, assert.AnError
+	}
+
+	if s.panicking {
+		panic(assert.AnError)
+	}
+
+	s.created++
+
+	version := int64(1)
+
+	return &apiv1.Role{
+		Id:      apiv1.EntityID(uuid.New()),
+		Name:    role.Name,
+		Version: &version,
+	}, nil
+}
+
+func TestIdempotency(t *testing.T) {
+	t.Parallel()
+
+	store := &idempotencyStore{keys: map[storage.IdempotencyKey]*storage.IdempotentRequest{}}
+
+	srv := newTestServer(t, store)
+	srv.engine.Use(gin.RecoveryWithWriter(io.Discard), srv.rtr.Idempotent)
+	srv.engine.POST("/roles", srv.rtr.CreateRole)
+
+	do := func(key, body string) *httptest.ResponseRecorder {
+		return srv.do(http.MethodPost, "/roles", body, "Idempotency-Key", key)
+	}
+
+	first := do("create-viewer", `{"name": "viewer"}`)
+	require.Equal(t, http.StatusOK, first.Code)
+	assert.Empty(t, first.Header().Get("Idempotent-Replayed"))
+
+	retried := do("create-viewer", `{"name": "viewer"}`)
+	require.Equal(t, http.StatusOK, retried.Code)
+	assert.Equal(t, "true", retried.Header().Get("Idempotent-Replayed"))
+	assert.Equal(t, first.Body.String(), retried.Body.String(), "replays the original response")
+	assert.Equal(t, first.Header().Get("ETag"), retried.Header().Get("ETag"))
+	assert.Equal(t, 1, store.created, "retries aren't applied again")
+
+	reused := do("create-viewer", `{"name": "editor"}`)
+	assert.Equal(t, http.StatusConflict, reused.Code, "keys can't be reused for other requests")
+
+	do("", `{"name": "viewer"}`)
+	do("", `{"name": "viewer"}`)
+	assert.Equal(t, 3, store.created, "requests without a key are always applied")
+
+	store.keys[storage.IdempotencyKey{Key: "in-progress"}] = &storage.IdempotentRequest{}
+
+	inProgress := do("in-progress", `{"name": "viewer"}`)
+	assert.Equal(t, http.StatusConflict, inProgress.Code)
+
+	tooLong := do(strings.Repeat("k", 256), `{"name": "viewer"}`)
+	assert.Equal(t, http.StatusBadRequest, tooLong.Code)
+
+	t.Run("server errors", func(t *testing.T) {
+		store.failing = true
+
+		failed := do("create-admin", `{"name": "admin"}`)
+		require.Equal(t, http.StatusInternalServerError, failed.Code)
+		assert.NotContains(t, store.keys, storage.IdempotencyKey{Key: "create-admin"}, "the key is released")
+
+		store.failing = false
+
+		retried := do("create-admin", `{"name": "admin"}`)
+		assert.Equal(t, http.StatusOK, retried.Code, "the request can be retried")
+		assert.Empty(t, retried.Header().Get("Idempotent-Replayed"))
+	})
+
+	t.Run("panics", func(t *testing.T) {
+		store.panicking = true
+
+		failed := do("create-owner", `{"name": "owner"}`)
+		require.Equal(t, http.StatusInternalServerError, failed.Code)
+		assert.NotContains(t, store.keys, storage.IdempotencyKey{Key: "create-owner"}, "the key is released")
+
+		store.panicking = false
+
+		retried := do("create-owner", `{"name": "owner"}`)
+		assert.Equal(t, http.StatusOK, retried.Code, "the request can be retried")
+		assert.Empty(t, retried.Header().Get("Idempotent-Replayed"))
+	})
+}
+
+func TestIdempotencyIfMatch(t *testing.T) {
+	t.Parallel()
+
+	version := int64(2)
+	id := apiv1.EntityID(uuid.New())
+
+	roles := &versionStore{role: &apiv1.Role{Id: id, Name: "viewer", Version: &version}}
+	store := &idempotencyStore{Storage: roles, keys: map[storage.IdempotencyKey]*storage.IdempotentRequest{}}
+
+	srv := newTestServer(t, store)
+	srv.engine.Use(srv.rtr.Idempotent)
+	srv.engine.PUT("/roles/:id", srv.rtr.UpdateRole)
+
+	do := func(ifMatch string) *httptest.ResponseRecorder {
+		return srv.do(http.MethodPut, "/roles/"+id.String(), `{"name": "editor"}`,
+			"Idempotency-Key", "rename-viewer", "If-Match", ifMatch)
+	}
+
+	stale := do(`"1"`)
+	require.Equal(t, http.StatusPreconditionFailed, stale.Code)
+
+	replayed := do(`"1"`)
+	assert.Equal(t, http.StatusPreconditionFailed, replayed.Code)
+	assert.Equal(t, "true", replayed.Header().Get("Idempotent-Replayed"))
+
+	refetched := do(`"2"`)
+	assert.Equal(t, http.StatusConflict, refetched.Code, "the key can't be reused with another ETag")
+	assert.Equal(t, "viewer", roles.role.Name)
+}
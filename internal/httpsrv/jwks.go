@@ -0,0 +1,188 @@
+package httpsrv
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"go.uber.org/zap"
+)
+
+const (
+	// jwksMinRefreshInterval is how long to wait before fetching the
+	// keys again, whether because a token is signed with an unknown key or
+	// because the last attempt failed, so that bogus tokens or an issuer
+	// that's down don't get hammered.
+	jwksMinRefreshInterval = time.Minute
+
+	jwksFetchTimeout = 10 * time.Second
+)
+
+// keySet gives the public keys tokens are signed with.
+type keySet struct {
+	load func(ctx context.Context) (*jose.JSONWebKeySet, error)
+
+	// refreshInterval is how often the keys are loaded again, or 0 if
+	// they're only loaded once.
+	refreshInterval time.Duration
+
+	logger *zap.Logger
+
+	mu       sync.Mutex
+	keys     *jose.JSONWebKeySet
+	loadedAt time.Time
+
+	// attemptedAt is when the keys were last loaded or failed to be,
+	// and err why they failed.
+	attemptedAt time.Time
+	err         error
+}
+
+// fileKeySet returns the key set in a file, which is loaded right away.
+func fileKeySet(path string, logger *zap.Logger) (*keySet, error) {
+	ks := &keySet{
+		logger: logger,
+		load: func(context.Context) (*jose.JSONWebKeySet, error) {
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return nil, err
+			}
+
+			return parseKeySet(data)
+		},
+	}
+
+	if err := ks.reload(context.Background()); err != nil {
+		return nil, err
+	}
+
+	return ks, nil
+}
+
+// remoteKeySet returns the key set at a URL. It's fetched when first
+// needed, then every refreshInterval and whenever a token is signed with
+// a key it doesn't have, to pick up rotated keys. Once fetched, the keys
+// are kept if fetching them again fails.
+func remoteKeySet(url string, refreshInterval time.Duration, logger *zap.Logger) *keySet {
+	client := &http.Client{
+		Timeout: jwksFetchTimeout,
+	}
+
+	return &keySet{
+		refreshInterval: refreshInterval,
+		logger:          logger,
+		load: func(ctx context.Context) (*jose.JSONWebKeySet, error) {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+			if err != nil {
+				return nil, err
+			}
+
+			resp, err := client.Do(req)
+			if err != nil {
+				return nil, err
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != http.StatusOK {
+				return nil, fmt.Errorf("unexpected status %s", resp.Status) //nolint:goerr113 // only logged
+			}
+
+			ks := &jose.JSONWebKeySet{}
+			if err := json.NewDecoder(resp.Body).Decode(ks); err != nil {
+				return nil, err
+			}
+
+			return ks, nil
+		},
+	}
+}
+
+func parseKeySet(data []byte) (*jose.JSONWebKeySet, error) {
+	ks := &jose.JSONWebKeySet{}
+	if err := json.Unmarshal(data, ks); err != nil {
+		return nil, err
+	}
+
+	return ks, nil
+}
+
+// lookup returns the keys with the given ID, or all the keys if the ID is
+// empty. An error is only returned if no keys could be loaded yet.
+func (ks *keySet) lookup(ctx context.Context, kid string) ([]jose.JSONWebKey, error) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	if ks.keys == nil {
+		if err := ks.refresh(ctx); err != nil {
+			return nil, err
+		}
+	} else if ks.refreshInterval > 0 && time.Since(ks.loadedAt) > ks.refreshInterval {
+		ks.refreshCached(ctx)
+	}
+
+	keys := ks.find(kid)
+
+	if len(keys) == 0 && ks.refreshInterval > 0 && time.Since(ks.attemptedAt) > jwksMinRefreshInterval {
+		ks.refreshCached(ctx)
+
+		keys = ks.find(kid)
+	}
+
+	return keys, nil
+}
+
+func (ks *keySet) find(kid string) []jose.JSONWebKey {
+	if kid == "" {
+		return ks.keys.Keys
+	}
+
+	return ks.keys.Key(kid)
+}
+
+// throttled returns whether the keys failed to load less than
+// jwksMinRefreshInterval ago.
+func (ks *keySet) throttled() bool {
+	return ks.err != nil && time.Since(ks.attemptedAt) < jwksMinRefreshInterval
+}
+
+// refresh loads the keys again, unless that just failed, in which case
+// the error is returned again.
+func (ks *keySet) refresh(ctx context.Context) error {
+	if ks.throttled() {
+		return ks.err
+	}
+
+	ks.err = ks.reload(ctx)
+	ks.attemptedAt = time.Now()
+
+	return ks.err
+}
+
+// refreshCached refreshes keys that are already loaded, keeping them if
+// that fails.
+func (ks *keySet) refreshCached(ctx context.Context) {
+	if ks.throttled() {
+		return
+	}
+
+	if err := ks.refresh(ctx); err != nil {
+		ks.logger.Warn("failed to refresh JWKS, keeping the current keys", zap.Error(err))
+	}
+}
+
+func (ks *keySet) reload(ctx context.Context) error {
+	keys, err := ks.load(ctx)
+	if err != nil {
+		return fmt.Errorf("couldn't load JWKS: %w", err)
+	}
+
+	ks.keys = keys
+	ks.loadedAt = time.Now()
+
+	return nil
+}
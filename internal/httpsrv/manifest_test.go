@@ -0,0 +1,70 @@
+package httpsrv_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+	"github.com/infratographer/lmi/internal/storage"
+)
+
+// manifestStore records the manifests that made it through.
+type manifestStore struct {
+	storage.Storage
+
+	manifests []apiv1.ServicePermissionManifest
+}
+
+func (s *manifestStore) RegisterServicePermissions(
+	_ context.Context,
+	_ string,
+	manifest apiv1.ServicePermissionManifest,
+) (*apiv1.ServicePermissionsDiff, error) {
+	s.manifests = append(s.manifests, manifest)
+
+	return &apiv1.ServicePermissionsDiff{
+		Added:    []string{},
+		Updated:  []string{},
+		Retired:  []string{},
+		Retained: []string{},
+	}, nil
+}
+
+func TestRegisterServicePermissions(t *testing.T) {
+	t.Parallel()
+
+	store := &manifestStore{}
+
+	srv := newTestServer(t, store)
+	srv.serveAPI()
+
+	do := func(contentType, body string) int {
+		return srv.do(http.MethodPut, "/api/v1/services/instances/permissions", body, "Content-Type", contentType).Code
+	}
+
+	description := "create instances"
+	want := apiv1.ServicePermissionManifest{
+		Permissions: []apiv1.Permission{
+			{Target: "create", Description: &description},
+			{Target: "delete"},
+		},
+	}
+
+	asJSON := `{"permissions": [{"target": "create", "description": "create instances"}, {"target": "delete"}]}`
+	asYAML := `
+permissions:
+  - target: create
+    description: create instances
+  - target: delete
+`
+
+	assert.Equal(t, http.StatusOK, do("application/json", asJSON))
+	assert.Equal(t, http.StatusOK, do("application/yaml", asYAML))
+	assert.Equal(t, http.StatusOK, do("application/x-yaml; charset=utf-8", asYAML))
+	assert.Equal(t, http.StatusBadRequest, do("application/json", asYAML))
+
+	assert.Equal(t, []apiv1.ServicePermissionManifest{want, want, want}, store.manifests)
+}
@@ -1,27 +1,85 @@
 package httpsrv
 
 import (
-	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/deepmap/oapi-codegen/pkg/runtime"
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 
 	apiv1 "github.com/infratographer/lmi/api/v1"
 	"github.com/infratographer/lmi/internal/storage"
 )
 
+// mimeYAML is the registered YAML media type, which gin only knows the
+// older application/x-yaml form of.
+const mimeYAML = "application/yaml"
+
+// errorReasons are the reasons given for the error statuses.
+var errorReasons = map[int]apiv1.ErrorReason{
+	http.StatusBadRequest:          apiv1.InvalidArgument,
+	http.StatusUnauthorized:        apiv1.Unauthenticated,
+	http.StatusForbidden:           apiv1.Forbidden,
+	http.StatusNotFound:            apiv1.NotFound,
+	http.StatusConflict:            apiv1.Conflict,
+	http.StatusPreconditionFailed:  apiv1.PreconditionFailed,
+	http.StatusUnprocessableEntity: apiv1.InvalidReference,
+}
+
+// ErrorHandler responds with an error. Internal errors are only logged,
+// so that they don't leak details of the storage.
 func (rtr *Router) ErrorHandler(gctx *gin.Context, err error, statusCode int) {
-	gctx.JSON(statusCode, gin.H{"msg": err.Error()})
+	apiErr := newAPIError(err, statusCode)
+
+	if apiErr.Reason == apiv1.Internal {
+		_ = gctx.Error(err)
+	}
+
+	gctx.AbortWithStatusJSON(statusCode, apiErr)
 }
 
+// ErrorChooser responds with an error, with the status matching its kind.
 func (rtr *Router) ErrorChooser(gctx *gin.Context, err error) {
-	switch {
-	case errors.Is(err, storage.ErrNotFound):
-		rtr.ErrorHandler(gctx, err, http.StatusNotFound)
+	rtr.ErrorHandler(gctx, err, errorStatus(err))
+}
+
+// newAPIError describes an error with the given status. The message of
+// internal errors is only the status text.
+func newAPIError(err error, statusCode int) apiv1.Error {
+	reason, ok := errorReasons[statusCode]
+	if !ok {
+		reason = apiv1.Internal
+	}
+
+	msg := err.Error()
+
+	if reason == apiv1.Internal {
+		msg = http.StatusText(statusCode)
+	}
+
+	return apiv1.Error{
+		Code:    int32(statusCode),
+		Reason:  reason,
+		Message: msg,
+	}
+}
+
+// errorStatus returns the status matching the kind of an error.
+func errorStatus(err error) int {
+	switch storage.Kind(err) {
+	case storage.ErrInvalidArgument:
+		return http.StatusBadRequest
+	case storage.ErrNotFound:
+		return http.StatusNotFound
+	case storage.ErrConflict:
+		return http.StatusConflict
+	case storage.ErrPreconditionFailed:
+		return http.StatusPreconditionFailed
+	case storage.ErrInvalidReference:
+		return http.StatusUnprocessableEntity
 	default:
-		rtr.ErrorHandler(gctx, err, http.StatusInternalServerError)
+		return http.StatusInternalServerError
 	}
 }
 
@@ -69,20 +127,56 @@ func (rtr *Router) GetAssignments(c *gin.Context) {
 }
 
 func (rtr *Router) GetPermissions(c *gin.Context) {
+	var err error
+
 	// Parameter object where we will unmarshal all parameters from the context
 	var params apiv1.GetPermissionsParams
 
-	// ------------- Required query parameter "target" -------------
+	// ------------- Optional query parameter "target" -------------
 
-	if paramValue := c.Query("target"); paramValue != "" {
-	} else {
-		rtr.ErrorHandler(c, fmt.Errorf("query argument target is required, but not found"), http.StatusBadRequest)
+	err = runtime.BindQueryParameter("form", true, false, "target", c.Request.URL.Query(), &params.Target)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter target: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	err := runtime.BindQueryParameter("form", true, true, "target", c.Request.URL.Query(), &params.Target)
+	// ------------- Optional query parameter "prefix" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "prefix", c.Request.URL.Query(), &params.Prefix)
 	if err != nil {
-		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter target: %w", err), http.StatusBadRequest)
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter prefix: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", c.Request.URL.Query(), &params.Order)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter order: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter cursor: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -95,55 +189,192 @@ func (rtr *Router) GetPermissions(c *gin.Context) {
 	c.JSON(http.StatusOK, perms)
 }
 
-func (rtr *Router) GetRoles(c *gin.Context) {
-	roles, err := rtr.store.GetRoles(c)
+func (rtr *Router) CreatePermission(c *gin.Context) {
+	perm := apiv1.Permission{}
+
+	if err := c.ShouldBindJSON(&perm); err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for permission: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	if err := apiv1.ValidateTarget(perm.Target); err != nil {
+		rtr.ErrorHandler(c, err, http.StatusBadRequest)
+		return
+	}
+
+	if !rtr.authorizeBase(c, apiv1.TargetPermissionsCreate) {
+		return
+	}
+
+	p, err := rtr.store.CreatePermission(c, perm)
 	if err != nil {
 		rtr.ErrorChooser(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, roles)
+	c.JSON(http.StatusOK, p)
 }
 
-func (rtr *Router) CreateRole(c *gin.Context) {
-	newRole := apiv1.NewRole{}
+func (rtr *Router) UpdatePermission(c *gin.Context) {
+	var err error
 
-	if err := c.BindJSON(&newRole); err != nil {
-		rtr.ErrorHandler(c, fmt.Errorf("invalid format for new role: %w", err), http.StatusBadRequest)
+	// ------------- Path parameter "target" -------------
+	var target string
+
+	err = runtime.BindStyledParameter("simple", false, "target", c.Param("target"), &target)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter target: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	r, err := rtr.store.CreateRole(c, newRole)
+	perm := &apiv1.Permission{}
+
+	if err := c.ShouldBindJSON(perm); err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for permission: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// The target in the path takes precedence.
+	perm.Target = target
+
+	if !rtr.authorizeBase(c, apiv1.TargetPermissionsUpdate) {
+		return
+	}
+
+	out, err := rtr.store.UpdatePermission(c, perm)
 	if err != nil {
 		rtr.ErrorChooser(c, err)
+		return
 	}
 
-	c.JSON(http.StatusOK, r)
+	c.JSON(http.StatusOK, out)
 }
 
-func (rtr *Router) DeleteRole(c *gin.Context) {
+func (rtr *Router) DeletePermission(c *gin.Context) {
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id apiv1.EntityID
+	// ------------- Path parameter "target" -------------
+	var target string
 
-	err = runtime.BindStyledParameter("simple", false, "id", c.Param("id"), &id)
+	err = runtime.BindStyledParameter("simple", false, "target", c.Param("target"), &target)
 	if err != nil {
-		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter id: %w", err), http.StatusBadRequest)
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter target: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	if !rtr.authorizeBase(c, apiv1.TargetPermissionsDelete) {
 		return
 	}
 
-	if err := rtr.store.DeleteRole(c, id); err != nil {
+	if err := rtr.store.DeletePermission(c, target); err != nil {
 		rtr.ErrorChooser(c, err)
 		return
 	}
+
+	c.Status(http.StatusNoContent)
 }
 
-func (rtr *Router) GetRole(c *gin.Context) {
+func (rtr *Router) RegisterServicePermissions(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameter("simple", false, "name", c.Param("name"), &name)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	if err := apiv1.ValidateServiceName(name); err != nil {
+		rtr.ErrorHandler(c, err, http.StatusBadRequest)
+		return
+	}
+
+	manifest := apiv1.ServicePermissionManifest{}
+
+	// Services often keep their manifest as YAML next to their code,
+	// so it can be sent as is.
+	bind := c.ShouldBindJSON
+
+	switch c.ContentType() {
+	case mimeYAML, binding.MIMEYAML:
+		bind = c.ShouldBindYAML
+	}
+
+	if err := bind(&manifest); err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for service permission manifest: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	if err := manifest.Validate(); err != nil {
+		rtr.ErrorHandler(c, err, http.StatusBadRequest)
+		return
+	}
+
+	if !rtr.authorizeBase(c, apiv1.TargetPermissionsRegister) {
+		return
+	}
+
+	diff, err := rtr.store.RegisterServicePermissions(c, name, manifest)
+	if err != nil {
+		rtr.ErrorChooser(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, diff)
+}
+
+// validateSubjectID checks the subject ID against the configured format, if any.
+func (rtr *Router) validateSubjectID(id string) error {
+	if rtr.subjectIDFormat == nil || rtr.subjectIDFormat.MatchString(id) {
+		return nil
+	}
+
+	return fmt.Errorf("invalid subject ID %q: doesn't match format %s", id, rtr.subjectIDFormat)
+}
+
+func (rtr *Router) GetSubjects(c *gin.Context) {
+	subjects, err := rtr.store.GetSubjects(c)
+	if err != nil {
+		rtr.ErrorChooser(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, subjects)
+}
+
+func (rtr *Router) CreateSubject(c *gin.Context) {
+	subject := apiv1.Subject{}
+
+	if err := c.ShouldBindJSON(&subject); err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for subject: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	if err := rtr.validateSubjectID(subject.Id); err != nil {
+		rtr.ErrorHandler(c, err, http.StatusBadRequest)
+		return
+	}
+
+	if !rtr.authorizeBase(c, apiv1.TargetSubjectsCreate) {
+		return
+	}
+
+	s, err := rtr.store.CreateSubject(c, subject)
+	if err != nil {
+		rtr.ErrorChooser(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, s)
+}
+
+func (rtr *Router) GetSubject(c *gin.Context) {
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id apiv1.EntityID
+	var id string
 
 	err = runtime.BindStyledParameter("simple", false, "id", c.Param("id"), &id)
 	if err != nil {
@@ -151,20 +382,20 @@ func (rtr *Router) GetRole(c *gin.Context) {
 		return
 	}
 
-	r, err := rtr.store.GetRole(c, id)
+	s, err := rtr.store.GetSubject(c, id)
 	if err != nil {
 		rtr.ErrorChooser(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, r)
+	c.JSON(http.StatusOK, s)
 }
 
-func (rtr *Router) UpdateRole(c *gin.Context) {
+func (rtr *Router) DeleteSubject(c *gin.Context) {
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id apiv1.EntityID
+	var id string
 
 	err = runtime.BindStyledParameter("simple", false, "id", c.Param("id"), &id)
 	if err != nil {
@@ -172,18 +403,24 @@ func (rtr *Router) UpdateRole(c *gin.Context) {
 		return
 	}
 
-	role := &apiv1.Role{}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params apiv1.DeleteSubjectParams
+
+	// ------------- Optional query parameter "dryRun" -------------
 
-	if err := c.BindJSON(role); err != nil {
-		rtr.ErrorHandler(c, fmt.Errorf("invalid format for role: %w", err), http.StatusBadRequest)
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter dryRun: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// The ID in the path takes precedence. It should normally match
-	// the ID in the body, but we don't enforce that.
-	role.Id = id
+	dryRun := params.DryRun != nil && *params.DryRun
+
+	if !rtr.authorizeBase(c, apiv1.TargetSubjectsDelete) {
+		return
+	}
 
-	out, err := rtr.store.UpdateRole(c, role)
+	out, err := rtr.store.DeleteSubject(c, id, dryRun)
 	if err != nil {
 		rtr.ErrorChooser(c, err)
 		return
@@ -192,38 +429,42 @@ func (rtr *Router) UpdateRole(c *gin.Context) {
 	c.JSON(http.StatusOK, out)
 }
 
-func (rtr *Router) RemoveRoleAssignment(c *gin.Context) {
-	var err error
-
-	// ------------- Path parameter "id" -------------
-	var id apiv1.EntityID
-
-	err = runtime.BindStyledParameter("simple", false, "id", c.Param("id"), &id)
+func (rtr *Router) GetGroups(c *gin.Context) {
+	groups, err := rtr.store.GetGroups(c)
 	if err != nil {
-		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter id: %w", err), http.StatusBadRequest)
+		rtr.ErrorChooser(c, err)
 		return
 	}
 
-	assignment := apiv1.Assignment{}
+	c.JSON(http.StatusOK, groups)
+}
 
-	if err := c.BindJSON(&assignment); err != nil {
-		rtr.ErrorHandler(c, fmt.Errorf("invalid format for assignment: %w", err), http.StatusBadRequest)
+func (rtr *Router) CreateGroup(c *gin.Context) {
+	group := apiv1.NewGroup{}
+
+	if err := c.ShouldBindJSON(&group); err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for group: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	assignment.Role = id
+	if !rtr.authorizeBase(c, apiv1.TargetGroupsCreate) {
+		return
+	}
 
-	if err := rtr.store.RemoveRoleAssignment(c, assignment); err != nil {
+	g, err := rtr.store.CreateGroup(c, group)
+	if err != nil {
 		rtr.ErrorChooser(c, err)
 		return
 	}
+
+	c.JSON(http.StatusOK, g)
 }
 
-func (rtr *Router) GetRoleAssignments(c *gin.Context) {
+func (rtr *Router) GetGroup(c *gin.Context) {
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id apiv1.EntityID
+	var id string
 
 	err = runtime.BindStyledParameter("simple", false, "id", c.Param("id"), &id)
 	if err != nil {
@@ -231,20 +472,20 @@ func (rtr *Router) GetRoleAssignments(c *gin.Context) {
 		return
 	}
 
-	as, err := rtr.store.GetRoleAssignments(c, id)
+	g, err := rtr.store.GetGroup(c, id)
 	if err != nil {
 		rtr.ErrorChooser(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, as)
+	c.JSON(http.StatusOK, g)
 }
 
-func (rtr *Router) AssignRole(c *gin.Context) {
+func (rtr *Router) UpdateGroup(c *gin.Context) {
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id apiv1.EntityID
+	var id string
 
 	err = runtime.BindStyledParameter("simple", false, "id", c.Param("id"), &id)
 	if err != nil {
@@ -252,24 +493,31 @@ func (rtr *Router) AssignRole(c *gin.Context) {
 		return
 	}
 
-	ras := apiv1.NewRoleAssignment{}
+	group := apiv1.NewGroup{}
 
-	if err := c.BindJSON(&ras); err != nil {
-		rtr.ErrorHandler(c, fmt.Errorf("invalid format for role assignment: %w", err), http.StatusBadRequest)
+	if err = c.ShouldBindJSON(&group); err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for group: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	if err := rtr.store.AssignRole(c, id, ras); err != nil {
+	if !rtr.authorizeBase(c, apiv1.TargetGroupsUpdate) {
+		return
+	}
+
+	g, err := rtr.store.UpdateGroup(c, id, group)
+	if err != nil {
 		rtr.ErrorChooser(c, err)
 		return
 	}
+
+	c.JSON(http.StatusOK, g)
 }
 
-func (rtr *Router) RemoveRolePermission(c *gin.Context) {
+func (rtr *Router) DeleteGroup(c *gin.Context) {
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id apiv1.EntityID
+	var id string
 
 	err = runtime.BindStyledParameter("simple", false, "id", c.Param("id"), &id)
 	if err != nil {
@@ -277,24 +525,23 @@ func (rtr *Router) RemoveRolePermission(c *gin.Context) {
 		return
 	}
 
-	pid := apiv1.PermissionIdentifier{}
-
-	if err := c.BindJSON(&pid); err != nil {
-		rtr.ErrorHandler(c, fmt.Errorf("invalid format for permission identifier: %w", err), http.StatusBadRequest)
+	if !rtr.authorizeBase(c, apiv1.TargetGroupsDelete) {
 		return
 	}
 
-	if err := rtr.store.RemoveRolePermission(c, id, pid); err != nil {
+	if err := rtr.store.DeleteGroup(c, id); err != nil {
 		rtr.ErrorChooser(c, err)
 		return
 	}
+
+	c.Status(http.StatusNoContent)
 }
 
-func (rtr *Router) GetRolePermissions(c *gin.Context) {
+func (rtr *Router) GetGroupMembers(c *gin.Context) {
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id apiv1.EntityID
+	var id string
 
 	err = runtime.BindStyledParameter("simple", false, "id", c.Param("id"), &id)
 	if err != nil {
@@ -302,20 +549,20 @@ func (rtr *Router) GetRolePermissions(c *gin.Context) {
 		return
 	}
 
-	perms, err := rtr.store.GetRolePermissions(c, id)
+	members, err := rtr.store.GetGroupMembers(c, id)
 	if err != nil {
 		rtr.ErrorChooser(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, perms)
+	c.JSON(http.StatusOK, members)
 }
 
-func (rtr *Router) AddRolePermission(c *gin.Context) {
+func (rtr *Router) AddGroupMember(c *gin.Context) {
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id apiv1.EntityID
+	var id string
 
 	err = runtime.BindStyledParameter("simple", false, "id", c.Param("id"), &id)
 	if err != nil {
@@ -323,15 +570,971 @@ func (rtr *Router) AddRolePermission(c *gin.Context) {
 		return
 	}
 
-	pid := apiv1.PermissionIdentifier{}
+	member := apiv1.Subject{}
 
-	if err = c.BindJSON(&pid); err != nil {
-		rtr.ErrorHandler(c, fmt.Errorf("invalid format for permission identifier: %w", err), http.StatusBadRequest)
+	if err = c.ShouldBindJSON(&member); err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for subject: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	if err := rtr.validateSubjectID(member.Id); err != nil {
+		rtr.ErrorHandler(c, err, http.StatusBadRequest)
+		return
+	}
+
+	if !rtr.authorizeBase(c, apiv1.TargetGroupsUpdate) {
+		return
+	}
+
+	if err := rtr.store.AddGroupMember(c, id, member); err != nil {
+		rtr.ErrorChooser(c, err)
+		return
+	}
+}
+
+func (rtr *Router) RemoveGroupMember(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameter("simple", false, "id", c.Param("id"), &id)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	member := apiv1.Subject{}
+
+	if err = c.ShouldBindJSON(&member); err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for subject: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	if err := rtr.store.AddRolePermission(c, id, pid); err != nil {
+	if !rtr.authorizeBase(c, apiv1.TargetGroupsUpdate) {
+		return
+	}
+
+	if err := rtr.store.RemoveGroupMember(c, id, member); err != nil {
 		rtr.ErrorChooser(c, err)
 		return
 	}
 }
+
+func (rtr *Router) GetRoles(c *gin.Context) {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params apiv1.GetRolesParams
+
+	// ------------- Optional query parameter "scope" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "scope", c.Request.URL.Query(), &params.Scope)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter scope: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", c.Request.URL.Query(), &params.Name)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "prefix" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "prefix", c.Request.URL.Query(), &params.Prefix)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter prefix: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", c.Request.URL.Query(), &params.Order)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter order: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	roles, err := rtr.store.GetRoles(c, &params)
+	if err != nil {
+		rtr.ErrorChooser(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, roles)
+}
+
+func (rtr *Router) CreateRole(c *gin.Context) {
+	newRole := apiv1.NewRole{}
+
+	if err := c.ShouldBindJSON(&newRole); err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for new role: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	if !rtr.authorize(c, apiv1.TargetRolesCreate, rtr.roleScope(newRole.Directory)) {
+		return
+	}
+
+	r, err := rtr.store.CreateRole(c, newRole)
+	if err != nil {
+		rtr.ErrorChooser(c, err)
+		return
+	}
+
+	setETag(c, r.Version)
+	c.JSON(http.StatusOK, r)
+}
+
+func (rtr *Router) DeleteRole(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id apiv1.EntityID
+
+	err = runtime.BindStyledParameter("simple", false, "id", c.Param("id"), &id)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	ifVersion, ok := rtr.ifMatch(c)
+	if !ok {
+		return
+	}
+
+	if !rtr.authorizeRole(c, apiv1.TargetRolesDelete, id) {
+		return
+	}
+
+	if err := rtr.store.DeleteRole(c, id, ifVersion); err != nil {
+		rtr.ErrorChooser(c, err)
+		return
+	}
+}
+
+func (rtr *Router) GetRole(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id apiv1.EntityID
+
+	err = runtime.BindStyledParameter("simple", false, "id", c.Param("id"), &id)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	r, err := rtr.store.GetRole(c, id)
+	if err != nil {
+		rtr.ErrorChooser(c, err)
+		return
+	}
+
+	setETag(c, r.Version)
+	c.JSON(http.StatusOK, r)
+}
+
+func (rtr *Router) UpdateRole(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id apiv1.EntityID
+
+	err = runtime.BindStyledParameter("simple", false, "id", c.Param("id"), &id)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	update := apiv1.RoleUpdate{}
+
+	if err := c.ShouldBindJSON(&update); err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for role: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	role := &apiv1.Role{
+		Id:          id,
+		Name:        update.Name,
+		Description: update.Description,
+	}
+
+	ifVersion, ok := rtr.ifMatch(c)
+	if !ok {
+		return
+	}
+
+	if !rtr.authorizeRole(c, apiv1.TargetRolesUpdate, id) {
+		return
+	}
+
+	out, err := rtr.store.UpdateRole(c, role, ifVersion)
+	if err != nil {
+		rtr.ErrorChooser(c, err)
+		return
+	}
+
+	setETag(c, out.Version)
+	c.JSON(http.StatusOK, out)
+}
+
+func (rtr *Router) RemoveRoleAssignment(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id apiv1.EntityID
+
+	err = runtime.BindStyledParameter("simple", false, "id", c.Param("id"), &id)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	assignment := apiv1.Assignment{}
+
+	if err := c.ShouldBindJSON(&assignment); err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for assignment: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	assignment.Role = id
+
+	if !rtr.authorize(c, apiv1.TargetAssignmentsDelete, assignment.Scope) {
+		return
+	}
+
+	if err := rtr.store.RemoveRoleAssignment(c, assignment); err != nil {
+		rtr.ErrorChooser(c, err)
+		return
+	}
+}
+
+func (rtr *Router) GetRoleAssignments(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id apiv1.EntityID
+
+	err = runtime.BindStyledParameter("simple", false, "id", c.Param("id"), &id)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params apiv1.GetRoleAssignmentsParams
+
+	// ------------- Optional query parameter "subject" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "subject", c.Request.URL.Query(), &params.Subject)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter subject: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "prefix" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "prefix", c.Request.URL.Query(), &params.Prefix)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter prefix: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "scope" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "scope", c.Request.URL.Query(), &params.Scope)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter scope: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", c.Request.URL.Query(), &params.Order)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter order: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	as, err := rtr.store.GetRoleAssignments(c, id, &params)
+	if err != nil {
+		rtr.ErrorChooser(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, as)
+}
+
+func (rtr *Router) AssignRole(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id apiv1.EntityID
+
+	err = runtime.BindStyledParameter("simple", false, "id", c.Param("id"), &id)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	ras := apiv1.NewRoleAssignment{}
+
+	if err := c.ShouldBindJSON(&ras); err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for role assignment: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	if err := rtr.validateSubjectID(ras.Subject); err != nil {
+		rtr.ErrorHandler(c, err, http.StatusBadRequest)
+		return
+	}
+
+	if err := ras.Validate(); err != nil {
+		rtr.ErrorHandler(c, err, http.StatusBadRequest)
+		return
+	}
+
+	if !rtr.authorize(c, apiv1.TargetAssignmentsCreate, ras.Scope) {
+		return
+	}
+
+	if err := rtr.store.AssignRole(c, id, ras); err != nil {
+		rtr.ErrorChooser(c, err)
+		return
+	}
+}
+
+// maxAssignmentChanges is the most assignment changes applied at once.
+const maxAssignmentChanges = 1000
+
+func (rtr *Router) ChangeAssignments(c *gin.Context) {
+	req := apiv1.BatchAssignmentRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for batch assignment request: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	if len(req.Changes) == 0 || len(req.Changes) > maxAssignmentChanges {
+		rtr.ErrorHandler(c, fmt.Errorf("batches take 1 to %d assignment changes", maxAssignmentChanges), http.StatusBadRequest)
+		return
+	}
+
+	// every change must be allowed for any to be applied
+	authorized := map[apiv1.CheckRequest]bool{}
+
+	for i, change := range req.Changes {
+		if err := rtr.validateAssignmentChange(change); err != nil {
+			rtr.ErrorHandler(c, fmt.Errorf("change %d: %w", i, err), http.StatusBadRequest)
+			return
+		}
+
+		target := apiv1.TargetAssignmentsCreate
+		if change.Op == apiv1.Unassign {
+			target = apiv1.TargetAssignmentsDelete
+		}
+
+		check := apiv1.CheckRequest{Target: target, Scope: change.Scope}
+		if authorized[check] {
+			continue
+		}
+
+		if !rtr.authorize(c, target, change.Scope) {
+			return
+		}
+
+		authorized[check] = true
+	}
+
+	atomic := req.Atomic != nil && *req.Atomic
+
+	errs, err := rtr.store.ChangeAssignments(c, req.Changes, atomic)
+	if err != nil {
+		rtr.ErrorChooser(c, err)
+		return
+	}
+
+	res := apiv1.BatchAssignmentResult{
+		Applied: true,
+		Results: make([]apiv1.AssignmentChangeResult, len(errs)),
+	}
+
+	for i, err := range errs {
+		if err == nil {
+			res.Results[i].Status = apiv1.Applied
+			continue
+		}
+
+		apiErr := newAPIError(err, errorStatus(err))
+
+		res.Results[i].Status = apiv1.Failed
+		res.Results[i].Error = &apiErr
+
+		if atomic {
+			res.Applied = false
+		}
+	}
+
+	if !res.Applied {
+		for i := range res.Results {
+			if res.Results[i].Status == apiv1.Applied {
+				res.Results[i].Status = apiv1.RolledBack
+			}
+		}
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (rtr *Router) validateAssignmentChange(change apiv1.AssignmentChange) error {
+	switch change.Op {
+	case apiv1.Assign:
+	case apiv1.Unassign:
+	default:
+		return fmt.Errorf("unknown op %q", change.Op)
+	}
+
+	if err := rtr.validateSubjectID(change.Subject); err != nil {
+		return err
+	}
+
+	ras := apiv1.NewRoleAssignment{
+		NotBefore: change.NotBefore,
+		ExpiresAt: change.ExpiresAt,
+	}
+
+	return ras.Validate()
+}
+
+func (rtr *Router) RemoveRolePermission(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id apiv1.EntityID
+
+	err = runtime.BindStyledParameter("simple", false, "id", c.Param("id"), &id)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	pid := apiv1.PermissionIdentifier{}
+
+	if err := c.ShouldBindJSON(&pid); err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for permission identifier: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	ifVersion, ok := rtr.ifMatch(c)
+	if !ok {
+		return
+	}
+
+	if !rtr.authorizeRole(c, apiv1.TargetRolesUpdate, id) {
+		return
+	}
+
+	version, err := rtr.store.RemoveRolePermission(c, id, pid, ifVersion)
+	if err != nil {
+		rtr.ErrorChooser(c, err)
+		return
+	}
+
+	setETag(c, &version)
+}
+
+func (rtr *Router) GetRolePermissions(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id apiv1.EntityID
+
+	err = runtime.BindStyledParameter("simple", false, "id", c.Param("id"), &id)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params apiv1.GetRolePermissionsParams
+
+	// ------------- Optional query parameter "expand" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "expand", c.Request.URL.Query(), &params.Expand)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter expand: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "prefix" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "prefix", c.Request.URL.Query(), &params.Prefix)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter prefix: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", c.Request.URL.Query(), &params.Order)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter order: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	perms, err := rtr.store.GetRolePermissions(c, id, &params)
+	if err != nil {
+		rtr.ErrorChooser(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, perms)
+}
+
+func (rtr *Router) AddRolePermission(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id apiv1.EntityID
+
+	err = runtime.BindStyledParameter("simple", false, "id", c.Param("id"), &id)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	pid := apiv1.PermissionIdentifier{}
+
+	if err = c.ShouldBindJSON(&pid); err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for permission identifier: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	if err := apiv1.ValidateTarget(pid.Target); err != nil {
+		rtr.ErrorHandler(c, err, http.StatusBadRequest)
+		return
+	}
+
+	ifVersion, ok := rtr.ifMatch(c)
+	if !ok {
+		return
+	}
+
+	if !rtr.authorizeRole(c, apiv1.TargetRolesUpdate, id) {
+		return
+	}
+
+	version, err := rtr.store.AddRolePermission(c, id, pid, ifVersion)
+	if err != nil {
+		rtr.ErrorChooser(c, err)
+		return
+	}
+
+	setETag(c, &version)
+}
+
+func (rtr *Router) GetRoleIncludes(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id apiv1.EntityID
+
+	err = runtime.BindStyledParameter("simple", false, "id", c.Param("id"), &id)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	roles, err := rtr.store.GetRoleIncludes(c, id)
+	if err != nil {
+		rtr.ErrorChooser(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, roles)
+}
+
+func (rtr *Router) AddRoleInclude(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id apiv1.EntityID
+
+	err = runtime.BindStyledParameter("simple", false, "id", c.Param("id"), &id)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	rid := apiv1.RoleIdentifier{}
+
+	if err = c.ShouldBindJSON(&rid); err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for role identifier: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	ifVersion, ok := rtr.ifMatch(c)
+	if !ok {
+		return
+	}
+
+	if !rtr.authorizeRole(c, apiv1.TargetRolesUpdate, id) {
+		return
+	}
+
+	version, err := rtr.store.AddRoleInclude(c, id, rid, ifVersion)
+	if err != nil {
+		rtr.ErrorChooser(c, err)
+		return
+	}
+
+	setETag(c, &version)
+}
+
+func (rtr *Router) RemoveRoleInclude(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id apiv1.EntityID
+
+	err = runtime.BindStyledParameter("simple", false, "id", c.Param("id"), &id)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	rid := apiv1.RoleIdentifier{}
+
+	if err = c.ShouldBindJSON(&rid); err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for role identifier: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	ifVersion, ok := rtr.ifMatch(c)
+	if !ok {
+		return
+	}
+
+	if !rtr.authorizeRole(c, apiv1.TargetRolesUpdate, id) {
+		return
+	}
+
+	version, err := rtr.store.RemoveRoleInclude(c, id, rid, ifVersion)
+	if err != nil {
+		rtr.ErrorChooser(c, err)
+		return
+	}
+
+	setETag(c, &version)
+}
+
+func (rtr *Router) GetDenyRules(c *gin.Context) {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params apiv1.GetDenyRulesParams
+
+	// ------------- Optional query parameter "subject" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "subject", c.Request.URL.Query(), &params.Subject)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter subject: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "role" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "role", c.Request.URL.Query(), &params.Role)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter role: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "scope" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "scope", c.Request.URL.Query(), &params.Scope)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter scope: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	rules, err := rtr.store.GetDenyRules(c, &params)
+	if err != nil {
+		rtr.ErrorChooser(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, rules)
+}
+
+func (rtr *Router) CreateDenyRule(c *gin.Context) {
+	newRule := apiv1.NewDenyRule{}
+
+	if err := c.ShouldBindJSON(&newRule); err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for new deny rule: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	if newRule.Subject != nil {
+		if err := rtr.validateSubjectID(*newRule.Subject); err != nil {
+			rtr.ErrorHandler(c, err, http.StatusBadRequest)
+			return
+		}
+	}
+
+	if err := newRule.Validate(); err != nil {
+		rtr.ErrorHandler(c, err, http.StatusBadRequest)
+		return
+	}
+
+	if !rtr.authorize(c, apiv1.TargetDeniesCreate, newRule.Scope) {
+		return
+	}
+
+	rule, err := rtr.store.CreateDenyRule(c, newRule)
+	if err != nil {
+		rtr.ErrorChooser(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, rule)
+}
+
+func (rtr *Router) GetDenyRule(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id apiv1.EntityID
+
+	err = runtime.BindStyledParameter("simple", false, "id", c.Param("id"), &id)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	rule, err := rtr.store.GetDenyRule(c, id)
+	if err != nil {
+		rtr.ErrorChooser(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, rule)
+}
+
+func (rtr *Router) DeleteDenyRule(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id apiv1.EntityID
+
+	err = runtime.BindStyledParameter("simple", false, "id", c.Param("id"), &id)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	if !rtr.authorizeDenyRule(c, apiv1.TargetDeniesDelete, id) {
+		return
+	}
+
+	if err := rtr.store.DeleteDenyRule(c, id); err != nil {
+		rtr.ErrorChooser(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (rtr *Router) CheckPermission(c *gin.Context) {
+	req := apiv1.CheckRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for check request: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	res, err := rtr.store.CheckPermission(c, req)
+	if err != nil {
+		rtr.ErrorChooser(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// maxBatchChecks is the most permission checks answered at once.
+const maxBatchChecks = 100
+
+func (rtr *Router) CheckPermissions(c *gin.Context) {
+	req := apiv1.BatchCheckRequest{}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for batch check request: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	if len(req.Checks) > maxBatchChecks {
+		rtr.ErrorHandler(c, fmt.Errorf("batches take at most %d checks", maxBatchChecks), http.StatusBadRequest)
+		return
+	}
+
+	results, err := rtr.store.CheckPermissions(c, req.Checks)
+	if err != nil {
+		rtr.ErrorChooser(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, apiv1.BatchCheckResult{Results: results})
+}
+
+func (rtr *Router) GetAuditEntries(c *gin.Context) {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params apiv1.GetAuditEntriesParams
+
+	// ------------- Optional query parameter "actor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor", c.Request.URL.Query(), &params.Actor)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter actor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "object" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "object", c.Request.URL.Query(), &params.Object)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter object: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "action", c.Request.URL.Query(), &params.Action)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter action: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", c.Request.URL.Query(), &params.Since)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter since: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", c.Request.URL.Query(), &params.Until)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter until: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		rtr.ErrorHandler(c, fmt.Errorf("invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	if !rtr.authorizeBase(c, apiv1.TargetAuditGet) {
+		return
+	}
+
+	entries, err := rtr.store.GetAuditEntries(c, &params)
+	if err != nil {
+		rtr.ErrorChooser(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, entries)
+}
@@ -4,7 +4,12 @@
 package httpsrv
 
 import (
+	"errors"
+	"regexp"
+	"time"
+
 	oapimdw "github.com/deepmap/oapi-codegen/pkg/gin-middleware"
+	"github.com/getkin/kin-openapi/openapi3filter"
 	"github.com/gin-gonic/gin"
 
 	apiv1 "github.com/infratographer/lmi/api/v1"
@@ -13,7 +18,13 @@ import (
 
 // Router converts contexts to parameters.
 type Router struct {
-	store storage.Storage
+	store           storage.Storage
+	subjectIDFormat *regexp.Regexp
+	auth            *Authenticator
+	authorization   bool
+	baseScope       string
+
+	idempotencyRetention time.Duration
 }
 
 // GinServerOptions provides options for the Gin server.
@@ -21,10 +32,26 @@ type GinServerOptions struct {
 	ErrorHandler func(*gin.Context, error, int)
 }
 
+// RouterOption configures the router.
+type RouterOption func(*Router)
+
+// WithSubjectIDFormat makes the router reject subject IDs that don't
+// match the given format when tracking subjects or assigning roles.
+func WithSubjectIDFormat(format *regexp.Regexp) RouterOption {
+	return func(rtr *Router) {
+		rtr.subjectIDFormat = format
+	}
+}
+
 // NewRouter creates http.Handler with routing matching OpenAPI spec.
-func NewRouter(store storage.Storage) *Router {
+func NewRouter(store storage.Storage, opts ...RouterOption) *Router {
 	rtr := &Router{
-		store: store,
+		store:                store,
+		idempotencyRetention: DefaultIdempotencyRetention,
+	}
+
+	for _, opt := range opts {
+		opt(rtr)
 	}
 
 	return rtr
@@ -39,12 +66,68 @@ func (rtr *Router) Routes(rg *gin.RouterGroup) {
 		panic(err)
 	}
 
-	rg.Use(oapimdw.OapiRequestValidator(swagger))
+	if rtr.auth != nil {
+		rg.Use(rtr.Authenticate)
+	}
+
+	rg.Use(oapimdw.OapiRequestValidatorWithOptions(swagger, &oapimdw.Options{
+		ErrorHandler: func(c *gin.Context, message string, statusCode int) {
+			rtr.ErrorHandler(c, errors.New(message), statusCode) //nolint:goerr113 // validation errors are only returned
+		},
+		Options: openapi3filter.Options{
+			// Bearer tokens are checked by Authenticate, when configured.
+			AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+		},
+	}))
+
+	rg.Use(rtr.Idempotent)
 
 	rg.GET("/assignments", rtr.GetAssignments)
 
+	rg.POST("/assignments/batch", rtr.ChangeAssignments)
+
 	rg.GET("/permissions", rtr.GetPermissions)
 
+	rg.POST("/permissions", rtr.CreatePermission)
+
+	rg.PUT("/permissions/:target", rtr.UpdatePermission)
+
+	rg.DELETE("/permissions/:target", rtr.DeletePermission)
+
+	rg.PUT("/services/:name/permissions", rtr.RegisterServicePermissions)
+
+	rg.GET("/denies", rtr.GetDenyRules)
+
+	rg.POST("/denies", rtr.CreateDenyRule)
+
+	rg.GET("/denies/:id", rtr.GetDenyRule)
+
+	rg.DELETE("/denies/:id", rtr.DeleteDenyRule)
+
+	rg.GET("/subjects", rtr.GetSubjects)
+
+	rg.POST("/subjects", rtr.CreateSubject)
+
+	rg.GET("/subjects/:id", rtr.GetSubject)
+
+	rg.DELETE("/subjects/:id", rtr.DeleteSubject)
+
+	rg.GET("/groups", rtr.GetGroups)
+
+	rg.POST("/groups", rtr.CreateGroup)
+
+	rg.GET("/groups/:id", rtr.GetGroup)
+
+	rg.PUT("/groups/:id", rtr.UpdateGroup)
+
+	rg.DELETE("/groups/:id", rtr.DeleteGroup)
+
+	rg.GET("/groups/:id/members", rtr.GetGroupMembers)
+
+	rg.POST("/groups/:id/members", rtr.AddGroupMember)
+
+	rg.DELETE("/groups/:id/members", rtr.RemoveGroupMember)
+
 	rg.GET("/roles", rtr.GetRoles)
 
 	rg.POST("/roles", rtr.CreateRole)
@@ -66,4 +149,16 @@ func (rtr *Router) Routes(rg *gin.RouterGroup) {
 	rg.GET("/roles/:id/permissions", rtr.GetRolePermissions)
 
 	rg.POST("/roles/:id/permissions", rtr.AddRolePermission)
+
+	rg.GET("/roles/:id/includes", rtr.GetRoleIncludes)
+
+	rg.POST("/roles/:id/includes", rtr.AddRoleInclude)
+
+	rg.DELETE("/roles/:id/includes", rtr.RemoveRoleInclude)
+
+	rg.POST("/check", rtr.CheckPermission)
+
+	rg.POST("/check/batch", rtr.CheckPermissions)
+
+	rg.GET("/audit", rtr.GetAuditEntries)
 }
@@ -4,12 +4,13 @@ import (
 	"go.infratographer.com/x/ginx"
 	"go.infratographer.com/x/versionx"
 	"go.uber.org/zap"
+
+	"github.com/infratographer/lmi/internal/storage"
 )
 
-func NewServer(logger *zap.SugaredLogger, cfg ginx.Config) *ginx.Server {
-	// TODO - Add a storage/controller implementation here.
-	router := NewRouter(nil)
-	server := ginx.NewServer(logger.Desugar(), cfg, versionx.BuildDetails())
+func NewServer(logger *zap.Logger, cfg ginx.Config, store storage.Storage, opts ...RouterOption) *ginx.Server {
+	router := NewRouter(store, opts...)
+	server := ginx.NewServer(logger, cfg, versionx.BuildDetails())
 	server = server.AddHandler(router)
 
 	return &server
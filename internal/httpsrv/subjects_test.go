@@ -0,0 +1,74 @@
+package httpsrv_test
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+	"github.com/infratographer/lmi/internal/httpsrv"
+	"github.com/infratographer/lmi/internal/storage"
+)
+
+// subjectStore records the subjects that made it through.
+type subjectStore struct {
+	storage.Storage
+
+	subjects []string
+}
+
+func (s *subjectStore) CreateSubject(_ context.Context, subject apiv1.Subject) (*apiv1.Subject, error) {
+	s.subjects = append(s.subjects, subject.Id)
+	return &subject, nil
+}
+
+func (s *subjectStore) AssignRole(_ context.Context, _ apiv1.EntityID, a apiv1.NewRoleAssignment) error {
+	s.subjects = append(s.subjects, a.Subject)
+	return nil
+}
+
+func (s *subjectStore) CreateDenyRule(_ context.Context, rule apiv1.NewDenyRule) (*apiv1.DenyRule, error) {
+	s.subjects = append(s.subjects, *rule.Subject)
+	return &apiv1.DenyRule{Id: apiv1.EntityID(uuid.New()), Subject: rule.Subject, Scope: rule.Scope, Target: rule.Target}, nil
+}
+
+func TestSubjectIDFormat(t *testing.T) {
+	t.Parallel()
+
+	const (
+		valid   = "urn:infratographer:user:someone"
+		invalid = "someone"
+	)
+
+	store := &subjectStore{}
+
+	srv := newTestServer(t, store, httpsrv.WithSubjectIDFormat(regexp.MustCompile(`^urn:infratographer:\w+:.+$`)))
+	srv.engine.POST("/subjects", srv.rtr.CreateSubject)
+	srv.engine.POST("/roles/:id/assignments", srv.rtr.AssignRole)
+	srv.engine.POST("/denies", srv.rtr.CreateDenyRule)
+
+	do := func(path, body string) int {
+		return srv.do(http.MethodPost, path, body).Code
+	}
+
+	assignments := "/roles/" + uuid.NewString() + "/assignments"
+
+	assert.Equal(t, http.StatusOK, do("/subjects", `{"id": "`+valid+`"}`))
+	assert.Equal(t, http.StatusBadRequest, do("/subjects", `{"id": "`+invalid+`"}`))
+
+	assert.Equal(t, http.StatusOK, do(assignments, `{"subject": "`+valid+`", "scope": "`+testBaseScope+`"}`))
+	assert.Equal(t, http.StatusBadRequest, do(assignments, `{"subject": "`+invalid+`", "scope": "`+testBaseScope+`"}`))
+
+	deny := func(subject string) string {
+		return `{"subject": "` + subject + `", "scope": "` + testBaseScope + `", "target": "instances.create"}`
+	}
+
+	assert.Equal(t, http.StatusOK, do("/denies", deny(valid)))
+	assert.Equal(t, http.StatusBadRequest, do("/denies", deny(invalid)))
+
+	assert.Equal(t, []string{valid, valid, valid}, store.subjects)
+}
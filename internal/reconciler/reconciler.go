@@ -2,25 +2,111 @@ package reconciler
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	apiv1 "github.com/infratographer/fertilesoil/api/v1"
 	appv1 "github.com/infratographer/fertilesoil/app/v1"
+	"go.uber.org/zap"
+
+	"github.com/infratographer/lmi/internal/storage"
 )
 
-type Reconciler struct{}
+type Reconciler struct {
+	store  storage.DirectoryStorage
+	logger *zap.Logger
+}
 
 var _ appv1.Reconciler = &Reconciler{}
 
-func NewReconciler() *Reconciler {
-	return &Reconciler{}
+func NewReconciler(store storage.DirectoryStorage, logger *zap.Logger) *Reconciler {
+	return &Reconciler{
+		store:  store,
+		logger: logger.Named("reconciler"),
+	}
 }
 
+// Reconcile looks at a directory event and performs the appropriate
+// action on the database. Note that the controller only sends us events
+// for directories we track, or for new children of tracked directories,
+// and it persists the directory itself before calling us.
+//
 //nolint:gocritic // passing the directory event by value ensures we don't modify it
 func (r *Reconciler) Reconcile(ctx context.Context, evt apiv1.DirectoryEvent) error {
-	// Here we will look at a directory event and perform the appropriate
-	// action on the database. e.g. if the event is a create, we will
-	// verify if we're tracking the parent directory, and if so, we will
-	// ensure the inherited permissions are applied to the new directory.
-	// deletions will be handled in a similar manner.
+	d := &evt.Directory
+
+	switch evt.Type {
+	case apiv1.EventTypeCreate, apiv1.EventTypeUpdate:
+		// A soft-deleted directory may show up in a create or update
+		// event, e.g. during a full reconcile.
+		if d.IsDeleted() {
+			return r.reconcileDelete(ctx, d)
+		}
+
+		return r.reconcileCreate(ctx, d)
+	case apiv1.EventTypeDelete, apiv1.EventTypeDeleteHard:
+		return r.reconcileDelete(ctx, d)
+	default:
+		return nil
+	}
+}
+
+// reconcileCreate records the new (or restored) directory in the tree and
+// ensures the permissions inherited from its ancestors are applied to it.
+// If we already know the directory under a different parent, it was moved.
+func (r *Reconciler) reconcileCreate(ctx context.Context, d *apiv1.Directory) error {
+	parent, err := r.store.GetDirectoryParent(ctx, d.Id)
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return fmt.Errorf("couldn't get parent of directory %s: %w", d.Id, err)
+	}
+
+	if err == nil && !sameDirectory(parent, d.Parent) {
+		return r.reconcileMove(ctx, d)
+	}
+
+	return r.store.AddDirectory(ctx, d.Id, d.Parent)
+}
+
+// reconcileMove recomputes the permissions of a directory that moved under
+// a new parent. The store publishes who gained or lost access in a
+// directories.moved event, and it's logged as well.
+func (r *Reconciler) reconcileMove(ctx context.Context, d *apiv1.Directory) error {
+	changes, err := r.store.MoveDirectory(ctx, d.Id, d.Parent)
+	if err != nil {
+		return fmt.Errorf("couldn't move directory %s: %w", d.Id, err)
+	}
+
+	r.logger.Info("directory moved",
+		zap.Stringer("directory", d.Id),
+		zap.Stringp("parent", parentString(d.Parent)),
+		zap.Strings("gained_access", changes.Gained),
+		zap.Strings("lost_access", changes.Lost),
+	)
+
 	return nil
 }
+
+// reconcileDelete revokes the effective permissions of the directory and
+// its whole subtree. The role assignments are kept so that access comes
+// back if the directory is restored.
+func (r *Reconciler) reconcileDelete(ctx context.Context, d *apiv1.Directory) error {
+	return r.store.RemoveDirectory(ctx, d.Id)
+}
+
+func sameDirectory(a, b *apiv1.DirectoryID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+func parentString(id *apiv1.DirectoryID) *string {
+	if id == nil {
+		return nil
+	}
+
+	s := id.String()
+
+	return &s
+}
@@ -0,0 +1,342 @@
+package reconciler_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach-go/v2/testserver"
+	"github.com/google/uuid"
+	fsv1 "github.com/infratographer/fertilesoil/api/v1"
+	appv1 "github.com/infratographer/fertilesoil/app/v1"
+	appv1sql "github.com/infratographer/fertilesoil/app/v1/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"go.uber.org/zap"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+	"github.com/infratographer/lmi/internal/reconciler"
+	"github.com/infratographer/lmi/internal/storage"
+	sqlstore "github.com/infratographer/lmi/internal/storage/sql"
+	"github.com/infratographer/lmi/internal/storage/sql/migrations"
+	"github.com/infratographer/lmi/internal/storage/sql/models"
+)
+
+const testTarget = "instances.create"
+
+type testEnv struct {
+	db       *sql.DB
+	appStore appv1.AppStorage
+	store    storage.Storage
+	r        *reconciler.Reconciler
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+
+	ts, err := testserver.NewTestServer()
+	require.NoError(t, err)
+	t.Cleanup(ts.Stop)
+
+	db, err := sql.Open("postgres", ts.PGURL().String())
+	require.NoError(t, err, "failed to open db connection")
+
+	require.NoError(t, migrations.Migrate(db), "failed to run migrations")
+
+	store := sqlstore.NewSQLDriver(db)
+
+	return &testEnv{
+		db:       db,
+		appStore: appv1sql.New(db),
+		store:    store,
+		r:        reconciler.NewReconciler(store, zap.NewNop()),
+	}
+}
+
+// send persists the directory the way the fertilesoil controller does
+// and then feeds the event to the reconciler.
+func (env *testEnv) send(ctx context.Context, t *testing.T, evtType fsv1.EventType, d *fsv1.Directory) {
+	t.Helper()
+
+	switch evtType {
+	case fsv1.EventTypeCreate:
+		_, err := env.appStore.CreateDirectory(ctx, d)
+		require.NoError(t, err)
+	case fsv1.EventTypeDelete:
+		require.NoError(t, env.appStore.DeleteDirectory(ctx, d.Id))
+	}
+
+	err := env.r.Reconcile(ctx, fsv1.DirectoryEvent{
+		DirectoryRequestMeta: fsv1.DirectoryRequestMeta{
+			Version: fsv1.APIVersion,
+		},
+		Type:      evtType,
+		Time:      time.Now().UTC(),
+		Directory: *d,
+	})
+	require.NoError(t, err)
+}
+
+// assignRole creates a subject and a role granting testTarget,
+// and assigns the role to the subject on the given scope.
+func (env *testEnv) assignRole(ctx context.Context, t *testing.T, subject string, scope fsv1.DirectoryID) apiv1.EntityID {
+	t.Helper()
+
+	ts := &models.TrackedSubject{SubjectID: subject}
+	require.NoError(t, ts.Insert(ctx, env.db, boil.Infer()))
+
+	perm := &models.Permission{Target: testTarget, Description: "create instances"}
+	require.NoError(t, perm.Upsert(ctx, env.db, false, nil, boil.Infer(), boil.Infer()))
+
+	role, err := env.store.CreateRole(ctx, apiv1.NewRole{Name: "instance-creator-" + subject})
+	require.NoError(t, err)
+
+	_, err = env.store.AddRolePermission(ctx, role.Id, apiv1.PermissionIdentifier{Target: testTarget}, nil)
+	require.NoError(t, err)
+	require.NoError(t, env.store.AssignRole(ctx, role.Id, apiv1.NewRoleAssignment{
+		Subject: subject,
+		Scope:   scope.String(),
+	}))
+
+	return role.Id
+}
+
+func (env *testEnv) check(ctx context.Context, t *testing.T, subject string, scope fsv1.DirectoryID) *apiv1.CheckResult {
+	t.Helper()
+
+	res, err := env.store.CheckPermission(ctx, apiv1.CheckRequest{
+		Subject: subject,
+		Target:  testTarget,
+		Scope:   scope.String(),
+	})
+	require.NoError(t, err)
+
+	return res
+}
+
+func newDirectory(parent *fsv1.Directory) *fsv1.Directory {
+	d := &fsv1.Directory{
+		Id:        fsv1.DirectoryID(uuid.New()),
+		Name:      "dir",
+		CreatedAt: time.Now().UTC(),
+		UpdatedAt: time.Now().UTC(),
+	}
+
+	if parent != nil {
+		pid := parent.Id
+		d.Parent = &pid
+	}
+
+	return d
+}
+
+func TestReconcileCreate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	env := newTestEnv(t)
+
+	root := newDirectory(nil)
+	env.send(ctx, t, fsv1.EventTypeCreate, root)
+
+	subject := "urn:infratographer:user:" + uuid.NewString()
+	roleID := env.assignRole(ctx, t, subject, root.Id)
+
+	t.Run("assignment applies to the scope", func(t *testing.T) {
+		res := env.check(ctx, t, subject, root.Id)
+		assert.True(t, res.Allowed)
+		assert.Equal(t, &roleID, res.Role)
+	})
+
+	t.Run("new children inherit permissions", func(t *testing.T) {
+		child := newDirectory(root)
+		env.send(ctx, t, fsv1.EventTypeCreate, child)
+
+		grandchild := newDirectory(child)
+		env.send(ctx, t, fsv1.EventTypeCreate, grandchild)
+
+		for _, d := range []*fsv1.Directory{child, grandchild} {
+			res := env.check(ctx, t, subject, d.Id)
+			assert.True(t, res.Allowed)
+			assert.Equal(t, &roleID, res.Role)
+		}
+	})
+
+	t.Run("siblings of the scope don't inherit permissions", func(t *testing.T) {
+		child := newDirectory(root)
+		env.send(ctx, t, fsv1.EventTypeCreate, child)
+
+		other := "urn:infratographer:user:" + uuid.NewString()
+		env.assignRole(ctx, t, other, child.Id)
+
+		sibling := newDirectory(root)
+		env.send(ctx, t, fsv1.EventTypeCreate, sibling)
+
+		assert.False(t, env.check(ctx, t, other, sibling.Id).Allowed)
+		assert.False(t, env.check(ctx, t, other, root.Id).Allowed)
+		assert.True(t, env.check(ctx, t, other, child.Id).Allowed)
+	})
+
+	t.Run("repeated create events are idempotent", func(t *testing.T) {
+		child := newDirectory(root)
+		env.send(ctx, t, fsv1.EventTypeCreate, child)
+
+		err := env.r.Reconcile(ctx, fsv1.DirectoryEvent{
+			Type:      fsv1.EventTypeCreate,
+			Time:      time.Now().UTC(),
+			Directory: *child,
+		})
+		require.NoError(t, err)
+
+		assert.True(t, env.check(ctx, t, subject, child.Id).Allowed)
+	})
+}
+
+func TestReconcileDelete(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	env := newTestEnv(t)
+
+	root := newDirectory(nil)
+	env.send(ctx, t, fsv1.EventTypeCreate, root)
+
+	child := newDirectory(root)
+	env.send(ctx, t, fsv1.EventTypeCreate, child)
+
+	grandchild := newDirectory(child)
+	env.send(ctx, t, fsv1.EventTypeCreate, grandchild)
+
+	subject := "urn:infratographer:user:" + uuid.NewString()
+	roleID := env.assignRole(ctx, t, subject, root.Id)
+
+	childSubject := "urn:infratographer:user:" + uuid.NewString()
+	childRoleID := env.assignRole(ctx, t, childSubject, child.Id)
+
+	t.Run("soft-deleting revokes the whole subtree", func(t *testing.T) {
+		deletedAt := time.Now().UTC()
+		child.DeletedAt = &deletedAt
+
+		env.send(ctx, t, fsv1.EventTypeDelete, child)
+
+		assert.True(t, env.check(ctx, t, subject, root.Id).Allowed)
+
+		for _, d := range []*fsv1.Directory{child, grandchild} {
+			assert.False(t, env.check(ctx, t, subject, d.Id).Allowed)
+			assert.False(t, env.check(ctx, t, childSubject, d.Id).Allowed)
+		}
+	})
+
+	t.Run("assignments are kept", func(t *testing.T) {
+		as, err := env.store.GetRoleAssignments(ctx, childRoleID, nil)
+		require.NoError(t, err)
+		require.Len(t, as.Items, 1)
+		assert.Equal(t, child.Id.String(), as.Items[0].Scope)
+	})
+
+	t.Run("restoring brings access back", func(t *testing.T) {
+		child.DeletedAt = nil
+
+		env.send(ctx, t, fsv1.EventTypeUpdate, child)
+
+		for _, d := range []*fsv1.Directory{child, grandchild} {
+			res := env.check(ctx, t, subject, d.Id)
+			assert.True(t, res.Allowed)
+			assert.Equal(t, &roleID, res.Role)
+
+			res = env.check(ctx, t, childSubject, d.Id)
+			assert.True(t, res.Allowed)
+			assert.Equal(t, &childRoleID, res.Role)
+		}
+	})
+
+	t.Run("hard deletes revoke permissions too", func(t *testing.T) {
+		env.send(ctx, t, fsv1.EventTypeDeleteHard, grandchild)
+
+		assert.False(t, env.check(ctx, t, subject, grandchild.Id).Allowed)
+		assert.True(t, env.check(ctx, t, subject, child.Id).Allowed)
+	})
+}
+
+func TestReconcileMove(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	env := newTestEnv(t)
+
+	root := newDirectory(nil)
+	env.send(ctx, t, fsv1.EventTypeCreate, root)
+
+	oldParent := newDirectory(root)
+	env.send(ctx, t, fsv1.EventTypeCreate, oldParent)
+
+	newParent := newDirectory(root)
+	env.send(ctx, t, fsv1.EventTypeCreate, newParent)
+
+	moved := newDirectory(oldParent)
+	env.send(ctx, t, fsv1.EventTypeCreate, moved)
+
+	movedChild := newDirectory(moved)
+	env.send(ctx, t, fsv1.EventTypeCreate, movedChild)
+
+	rootSubject := "urn:infratographer:user:" + uuid.NewString()
+	env.assignRole(ctx, t, rootSubject, root.Id)
+
+	oldSubject := "urn:infratographer:user:" + uuid.NewString()
+	env.assignRole(ctx, t, oldSubject, oldParent.Id)
+
+	newSubject := "urn:infratographer:user:" + uuid.NewString()
+	newRoleID := env.assignRole(ctx, t, newSubject, newParent.Id)
+
+	t.Run("moving swaps the inherited permissions", func(t *testing.T) {
+		pid := newParent.Id
+		moved.Parent = &pid
+
+		env.send(ctx, t, fsv1.EventTypeUpdate, moved)
+
+		for _, d := range []*fsv1.Directory{moved, movedChild} {
+			assert.True(t, env.check(ctx, t, rootSubject, d.Id).Allowed)
+			assert.False(t, env.check(ctx, t, oldSubject, d.Id).Allowed)
+
+			res := env.check(ctx, t, newSubject, d.Id)
+			assert.True(t, res.Allowed)
+			assert.Equal(t, &newRoleID, res.Role)
+		}
+
+		assert.True(t, env.check(ctx, t, oldSubject, oldParent.Id).Allowed)
+	})
+
+	t.Run("moves are summarized", func(t *testing.T) {
+		rows, err := models.EventOutboxes(
+			models.EventOutboxWhere.EventType.EQ(string(apiv1.EventDirectoryMoved)),
+		).All(ctx, env.db)
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+
+		evt := &apiv1.Event{}
+		require.NoError(t, rows[0].Payload.Unmarshal(evt))
+
+		newParentID := newParent.Id.String()
+
+		assert.Equal(t, &apiv1.DirectoryMove{
+			Directory: moved.Id.String(),
+			Parent:    &newParentID,
+			Gained:    []string{newSubject},
+			Lost:      []string{oldSubject},
+		}, evt.Move)
+	})
+
+	t.Run("moving into its own subtree fails", func(t *testing.T) {
+		pid := movedChild.Id
+		moved.Parent = &pid
+
+		err := env.r.Reconcile(ctx, fsv1.DirectoryEvent{
+			Type:      fsv1.EventTypeUpdate,
+			Time:      time.Now().UTC(),
+			Directory: *moved,
+		})
+		assert.ErrorIs(t, err, storage.ErrDirectoryCycle)
+	})
+}
@@ -0,0 +1,20 @@
+package storage
+
+import "context"
+
+// ActorKey is the context key of the subject changes are made on behalf
+// of, which is recorded in the audit log. It's a string so that the actor
+// can also be set on gin contexts, which only look up string keys.
+const ActorKey = "lmi.actor"
+
+// WithActor returns a copy of ctx making changes on behalf of actor.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, ActorKey, actor) //nolint:staticcheck // see ActorKey
+}
+
+// ActorFromContext returns the subject changes made with ctx are made on
+// behalf of, or an empty string if they aren't made on behalf of anyone.
+func ActorFromContext(ctx context.Context) string {
+	actor, _ := ctx.Value(ActorKey).(string)
+	return actor
+}
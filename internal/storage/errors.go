@@ -2,4 +2,100 @@ package storage
 
 import "errors"
 
-var ErrNotFound = errors.New("not found")
+// Kinds of errors. The errors returned by storage are of one of these
+// kinds, which errors.Is tells, or are internal errors.
+var (
+	// ErrNotFound is returned for objects that don't exist.
+	ErrNotFound = errors.New("not found")
+
+	// ErrConflict is returned for changes that conflict with the current
+	// state, such as creating an object that already exists.
+	ErrConflict = errors.New("conflict")
+
+	// ErrInvalidReference is returned for changes referring to objects
+	// that can't be referred to, because they don't exist or aren't
+	// suitable.
+	ErrInvalidReference = errors.New("invalid reference")
+
+	// ErrPreconditionFailed is returned for changes whose precondition on
+	// the current state doesn't hold.
+	ErrPreconditionFailed = errors.New("precondition failed")
+
+	// ErrInvalidArgument is returned for malformed arguments.
+	ErrInvalidArgument = errors.New("invalid argument")
+)
+
+// kinds are the kinds of errors, most specific first.
+var kinds = []error{
+	ErrNotFound,
+	ErrConflict,
+	ErrInvalidReference,
+	ErrPreconditionFailed,
+	ErrInvalidArgument,
+}
+
+var (
+	ErrAlreadyExists = NewError(ErrConflict, "already exists")
+
+	ErrPermissionInUse = NewError(ErrConflict, "permission is used by roles")
+
+	ErrSubjectNotTracked = NewError(ErrInvalidReference, "subject is not tracked")
+
+	ErrDirectoryCycle = NewError(ErrInvalidReference, "directory can't be moved into its own subtree")
+
+	ErrRoleNotInScope = NewError(ErrInvalidReference, "role is not defined in the scope")
+
+	ErrRoleCycle = NewError(ErrInvalidReference, "role can't include itself")
+
+	ErrGroupCycle = NewError(ErrInvalidReference, "group can't contain itself")
+
+	ErrInvalidCursor = NewError(ErrInvalidArgument, "invalid cursor")
+
+	ErrInvalidSort = NewError(ErrInvalidArgument, "invalid sort")
+
+	ErrVersionMismatch = NewError(ErrPreconditionFailed, "version doesn't match")
+)
+
+// kindError is an error of a given kind.
+type kindError struct {
+	kind error
+	msg  string
+	err  error
+}
+
+// NewError returns a new error of the given kind.
+func NewError(kind error, msg string) error {
+	return &kindError{kind: kind, msg: msg}
+}
+
+// WithKind returns an error of the given kind wrapping err.
+func WithKind(kind, err error) error {
+	return &kindError{kind: kind, err: err}
+}
+
+func (e *kindError) Error() string {
+	if e.err != nil {
+		return e.err.Error()
+	}
+
+	return e.msg
+}
+
+func (e *kindError) Unwrap() error {
+	return e.err
+}
+
+func (e *kindError) Is(target error) bool {
+	return target == e.kind
+}
+
+// Kind returns the kind of an error, or nil for internal errors.
+func Kind(err error) error {
+	for _, kind := range kinds {
+		if errors.Is(err, kind) {
+			return kind
+		}
+	}
+
+	return nil
+}
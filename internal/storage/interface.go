@@ -2,34 +2,268 @@ package storage
 
 import (
 	"context"
+	"time"
+
+	fsv1 "github.com/infratographer/fertilesoil/api/v1"
 
 	apiv1 "github.com/infratographer/lmi/api/v1"
 )
 
 type Storage interface {
+	DirectoryStorage
+	AssignmentExpiryStorage
+	OutboxStorage
+	AuditStorage
+	IdempotencyStorage
+
 	GetAssignments(c context.Context, params *apiv1.GetAssignmentsParams) ([]*apiv1.Assignment, error)
 
-	GetPermissions(c context.Context, params *apiv1.GetPermissionsParams) ([]*apiv1.Permission, error)
+	GetPermissions(c context.Context, params *apiv1.GetPermissionsParams) (*apiv1.PermissionList, error)
+
+	CreatePermission(c context.Context, perm apiv1.Permission) (*apiv1.Permission, error)
+
+	UpdatePermission(c context.Context, perm *apiv1.Permission) (*apiv1.Permission, error)
+
+	DeletePermission(c context.Context, target string) error
+
+	RegisterServicePermissions(
+		c context.Context,
+		service string,
+		manifest apiv1.ServicePermissionManifest,
+	) (*apiv1.ServicePermissionsDiff, error)
+
+	GetSubjects(c context.Context) ([]*apiv1.Subject, error)
+
+	CreateSubject(c context.Context, subject apiv1.Subject) (*apiv1.Subject, error)
+
+	GetSubject(c context.Context, id string) (*apiv1.Subject, error)
+
+	DeleteSubject(c context.Context, id string, dryRun bool) (*apiv1.SubjectDeletion, error)
+
+	GetGroups(c context.Context) ([]*apiv1.Group, error)
+
+	CreateGroup(c context.Context, group apiv1.NewGroup) (*apiv1.Group, error)
+
+	GetGroup(c context.Context, id string) (*apiv1.Group, error)
+
+	UpdateGroup(c context.Context, id string, group apiv1.NewGroup) (*apiv1.Group, error)
+
+	DeleteGroup(c context.Context, id string) error
 
-	GetRoles(c context.Context) ([]*apiv1.RoleInfo, error)
+	GetGroupMembers(c context.Context, id string) ([]*apiv1.Subject, error)
+
+	AddGroupMember(c context.Context, id string, member apiv1.Subject) error
+
+	RemoveGroupMember(c context.Context, id string, member apiv1.Subject) error
+
+	GetRoles(c context.Context, params *apiv1.GetRolesParams) (*apiv1.RoleList, error)
 
 	CreateRole(c context.Context, role apiv1.NewRole) (*apiv1.Role, error)
 
-	DeleteRole(c context.Context, id apiv1.EntityID) error
+	DeleteRole(c context.Context, id apiv1.EntityID, ifVersion *int64) error
 
 	GetRole(c context.Context, id apiv1.EntityID) (*apiv1.Role, error)
 
-	UpdateRole(c context.Context, role *apiv1.Role) (*apiv1.Role, error)
+	// UpdateRole updates a role. The changes to roles and their permissions
+	// are only made if ifVersion is nil or the role is at that version, and
+	// fail with ErrVersionMismatch otherwise.
+	UpdateRole(c context.Context, role *apiv1.Role, ifVersion *int64) (*apiv1.Role, error)
 
 	RemoveRoleAssignment(c context.Context, a apiv1.Assignment) error
 
-	GetRoleAssignments(c context.Context, roleID apiv1.EntityID) ([]*apiv1.Assignment, error)
+	GetRoleAssignments(
+		c context.Context,
+		roleID apiv1.EntityID,
+		params *apiv1.GetRoleAssignmentsParams,
+	) (*apiv1.AssignmentList, error)
 
 	AssignRole(c context.Context, roleID apiv1.EntityID, assignment apiv1.NewRoleAssignment) error
 
-	RemoveRolePermission(c context.Context, id apiv1.EntityID, targetID apiv1.PermissionIdentifier) error
+	// ChangeAssignments applies assignment changes in a single
+	// transaction, returning the error each change failed with, if any.
+	// Changes that fail are skipped, unless atomic is set: then none is
+	// made if any fails.
+	ChangeAssignments(c context.Context, changes []apiv1.AssignmentChange, atomic bool) ([]error, error)
+
+	RemoveRolePermission(
+		c context.Context,
+		id apiv1.EntityID,
+		targetID apiv1.PermissionIdentifier,
+		ifVersion *int64,
+	) (int64, error)
+
+	GetRolePermissions(
+		c context.Context,
+		id apiv1.EntityID,
+		params *apiv1.GetRolePermissionsParams,
+	) (*apiv1.PermissionList, error)
+
+	AddRolePermission(
+		c context.Context,
+		id apiv1.EntityID,
+		targetID apiv1.PermissionIdentifier,
+		ifVersion *int64,
+	) (int64, error)
+
+	GetRoleIncludes(c context.Context, id apiv1.EntityID) ([]*apiv1.RoleInfo, error)
+
+	AddRoleInclude(
+		c context.Context,
+		id apiv1.EntityID,
+		included apiv1.RoleIdentifier,
+		ifVersion *int64,
+	) (int64, error)
+
+	RemoveRoleInclude(
+		c context.Context,
+		id apiv1.EntityID,
+		included apiv1.RoleIdentifier,
+		ifVersion *int64,
+	) (int64, error)
+
+	GetDenyRules(c context.Context, params *apiv1.GetDenyRulesParams) ([]*apiv1.DenyRule, error)
+
+	CreateDenyRule(c context.Context, rule apiv1.NewDenyRule) (*apiv1.DenyRule, error)
+
+	GetDenyRule(c context.Context, id apiv1.EntityID) (*apiv1.DenyRule, error)
+
+	DeleteDenyRule(c context.Context, id apiv1.EntityID) error
+
+	CheckPermission(c context.Context, req apiv1.CheckRequest) (*apiv1.CheckResult, error)
 
-	GetRolePermissions(c context.Context, id apiv1.EntityID) ([]*apiv1.Permission, error)
+	CheckPermissions(c context.Context, reqs []apiv1.CheckRequest) ([]apiv1.CheckResult, error)
+}
+
+// AssignmentExpiryStorage keeps the effective permissions in line with
+// the time bounds of role assignments.
+type AssignmentExpiryStorage interface {
+	// SweepRoleAssignments removes the effective permissions whose role
+	// assignments have all expired, and applies the role assignments that
+	// became active after the given time. The removed permissions are
+	// returned, and an event is recorded for every subject they belong to.
+	SweepRoleAssignments(c context.Context, since time.Time) ([]LapsedPermission, error)
+}
+
+// LapsedPermission is an effective permission removed because the role
+// assignments granting it expired.
+type LapsedPermission struct {
+	Subject string
+	Target  string
+	Scope   string
+	Role    string
+}
+
+// OutboxStorage gives access to the events written to the outbox along
+// with the changes they describe, so they can be relayed.
+type OutboxStorage interface {
+	// RelayEvents passes up to limit undelivered events to deliver, oldest
+	// first, and marks them delivered. It stops at the first event deliver
+	// fails on, recording the failure so the event is retried next time,
+	// and returns how many events were delivered. Events are locked while
+	// they're relayed, so concurrent relays don't deliver them out of order.
+	RelayEvents(c context.Context, limit int, deliver func(evt OutboxEvent) error) (int, error)
+
+	// PruneEvents removes the events delivered before the given time, and
+	// returns how many were removed.
+	PruneEvents(c context.Context, before time.Time) (int64, error)
+}
+
+// OutboxEvent is an event waiting in the outbox to be delivered.
+type OutboxEvent struct {
+	ID       int64
+	Type     apiv1.EventType
+	Payload  []byte
+	Attempts int64
+}
+
+// IdempotencyStorage keeps the responses to the requests made with an
+// idempotency key, so that retried requests get the original response
+// instead of being applied again. Keys are scoped to the actor making the
+// request.
+type IdempotencyStorage interface {
+	// ReserveIdempotencyKey reserves a key for the request with the given
+	// fingerprint until expiresAt, and returns nil. If the key is already
+	// reserved and hasn't expired, it's left as is and the request it was
+	// reserved for is returned instead.
+	ReserveIdempotencyKey(
+		c context.Context,
+		key IdempotencyKey,
+		fingerprint string,
+		expiresAt time.Time,
+	) (*IdempotentRequest, error)
+
+	// SaveIdempotentResponse records the response to the request a key
+	// was reserved for.
+	SaveIdempotentResponse(c context.Context, key IdempotencyKey, resp IdempotentResponse) error
+
+	// ReleaseIdempotencyKey removes the reservation of a key whose request
+	// has no response yet, so that the request can be made again.
+	ReleaseIdempotencyKey(c context.Context, key IdempotencyKey) error
+
+	// PruneIdempotencyKeys removes the keys that expired before the given
+	// time, and returns how many were removed.
+	PruneIdempotencyKeys(c context.Context, before time.Time) (int64, error)
+}
+
+// IdempotencyKey is the key an actor made a request with.
+type IdempotencyKey struct {
+	Actor string
+	Key   string
+}
+
+// IdempotentRequest is a request made with an idempotency key.
+type IdempotentRequest struct {
+	// Fingerprint tells requests made with the same key apart.
+	Fingerprint string
+
+	// Response is nil while the request is being processed.
+	Response *IdempotentResponse
+}
+
+// IdempotentResponse is the response to a request made with an idempotency
+// key, to be returned again when the request is retried.
+type IdempotentResponse struct {
+	Status int
+	Header map[string]string
+	Body   []byte
+}
+
+// AuditStorage gives access to the audit log, which records every change
+// made through Storage along with the actor set on its context with
+// WithActor.
+type AuditStorage interface {
+	// GetAuditEntries returns the audit entries matching the given
+	// filters, newest first. ErrInvalidCursor is returned if the cursor
+	// doesn't come from a previous page.
+	GetAuditEntries(c context.Context, params *apiv1.GetAuditEntriesParams) (*apiv1.AuditLog, error)
+}
+
+// DirectoryStorage keeps track of the directory tree as seen by LMI
+// and of the permissions that are inherited through it.
+type DirectoryStorage interface {
+	// AddDirectory records a new or restored directory under the given
+	// parent and applies the permissions it inherits from its ancestors.
+	AddDirectory(c context.Context, id fsv1.DirectoryID, parent *fsv1.DirectoryID) error
+
+	// RemoveDirectory marks a directory as deleted and revokes the effective
+	// permissions of its whole subtree. Role assignments are kept so that
+	// they apply again if the directory is restored.
+	RemoveDirectory(c context.Context, id fsv1.DirectoryID) error
+
+	// GetDirectoryParent returns the parent LMI has recorded for a directory,
+	// which is nil for root directories. ErrNotFound is returned if the
+	// directory isn't known.
+	GetDirectoryParent(c context.Context, id fsv1.DirectoryID) (*fsv1.DirectoryID, error)
+
+	// MoveDirectory moves a directory under a new parent and recomputes the
+	// effective permissions of its subtree, dropping the ones inherited from
+	// the old ancestors and applying the ones from the new ancestors.
+	MoveDirectory(c context.Context, id fsv1.DirectoryID, parent *fsv1.DirectoryID) (*AccessChanges, error)
+}
 
-	AddRolePermission(c context.Context, id apiv1.EntityID, targetID apiv1.PermissionIdentifier) error
+// AccessChanges summarizes which subjects gained or lost effective
+// permissions as the result of a change.
+type AccessChanges struct {
+	Gained []string
+	Lost   []string
 }
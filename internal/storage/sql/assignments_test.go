@@ -0,0 +1,106 @@
+package sql_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+	"github.com/infratographer/lmi/internal/storage"
+	sqlstore "github.com/infratographer/lmi/internal/storage/sql"
+	"github.com/infratographer/lmi/internal/storage/sql/models"
+)
+
+func TestChangeAssignments(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	env := newTestEnv(t, sqlstore.WithAutoTrackSubjects(true))
+
+	root := env.addDirectory(ctx, t, nil)
+	child := env.addDirectory(ctx, t, &root)
+	sibling := env.addDirectory(ctx, t, &root)
+
+	childDir := child.String()
+
+	roleID := env.createRole(ctx, t, apiv1.NewRole{Name: "instance-creator"}, testTarget)
+	childRole := env.createRole(ctx, t, apiv1.NewRole{Name: "child-admin", Directory: &childDir}, testTarget)
+	unknownRole := apiv1.EntityID(uuid.New())
+
+	assign := func(role apiv1.EntityID, subject string, scope string) apiv1.AssignmentChange {
+		return apiv1.AssignmentChange{Op: apiv1.Assign, Role: role, Subject: subject, Scope: scope}
+	}
+
+	// state returns the rows the changes could have left behind.
+	state := func(t *testing.T, subjects ...string) (assignments, tracked, outbox, audited int64) {
+		t.Helper()
+
+		var err error
+
+		assignments, err = models.RoleAssignments(models.RoleAssignmentWhere.SubjectID.IN(subjects)).Count(ctx, env.db)
+		require.NoError(t, err)
+
+		tracked, err = models.TrackedSubjects(models.TrackedSubjectWhere.SubjectID.IN(subjects)).Count(ctx, env.db)
+		require.NoError(t, err)
+
+		outbox, err = models.EventOutboxes().Count(ctx, env.db)
+		require.NoError(t, err)
+
+		audited, err = models.AuditLogs().Count(ctx, env.db)
+		require.NoError(t, err)
+
+		return assignments, tracked, outbox, audited
+	}
+
+	t.Run("failed changes are skipped", func(t *testing.T) {
+		applied, skipped := newSubject(), newSubject()
+
+		errs, err := env.store.ChangeAssignments(ctx, []apiv1.AssignmentChange{
+			assign(roleID, applied, root.String()),
+			assign(unknownRole, skipped, root.String()),
+			assign(childRole, skipped, sibling.String()),
+		}, false)
+		require.NoError(t, err)
+		require.Len(t, errs, 3)
+
+		assert.NoError(t, errs[0])
+		assert.ErrorIs(t, errs[1], storage.ErrNotFound)
+		assert.ErrorIs(t, errs[2], storage.ErrRoleNotInScope)
+
+		assert.True(t, env.check(ctx, t, applied, testTarget, child).Allowed)
+
+		assignments, tracked, _, _ := state(t, skipped)
+		assert.Zero(t, assignments)
+		assert.Zero(t, tracked)
+	})
+
+	t.Run("atomic changes are all rolled back if one fails", func(t *testing.T) {
+		first, second := newSubject(), newSubject()
+
+		_, _, outbox, audited := state(t)
+
+		errs, err := env.store.ChangeAssignments(ctx, []apiv1.AssignmentChange{
+			assign(roleID, first, root.String()),
+			assign(childRole, second, child.String()),
+			assign(childRole, second, sibling.String()),
+		}, true)
+		require.NoError(t, err)
+		require.Len(t, errs, 3)
+
+		assert.NoError(t, errs[0])
+		assert.NoError(t, errs[1])
+		assert.ErrorIs(t, errs[2], storage.ErrRoleNotInScope)
+
+		assignments, tracked, outboxAfter, auditedAfter := state(t, first, second)
+		assert.Zero(t, assignments, "no partial assignments")
+		assert.Zero(t, tracked, "no subjects tracked")
+		assert.Equal(t, outbox, outboxAfter, "no events")
+		assert.Equal(t, audited, auditedAfter, "no audit entries")
+
+		assert.False(t, env.check(ctx, t, first, testTarget, root).Allowed)
+		assert.False(t, env.check(ctx, t, second, testTarget, child).Allowed)
+	})
+}
@@ -0,0 +1,327 @@
+package sql
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+	"github.com/infratographer/lmi/internal/storage"
+	"github.com/infratographer/lmi/internal/storage/sql/models"
+)
+
+// auditEntry is a change made in a transaction, to be written to the
+// audit log along with the actor of the transaction.
+type auditEntry struct {
+	action  string
+	objects []string
+	before  interface{}
+	after   interface{}
+}
+
+// audit records a change made to the given objects, along with their
+// state before and after it. before is nil for creations and after is
+// nil for deletions.
+func (tx *txn) audit(action string, objects []string, before, after interface{}) {
+	tx.audited = append(tx.audited, &auditEntry{
+		action:  action,
+		objects: objects,
+		before:  before,
+		after:   after,
+	})
+}
+
+// auditEvent audits the change described by an event. The event's
+// details are the state after the change, or before it for removals,
+// unless the state before the change is given.
+func (tx *txn) auditEvent(evt *apiv1.Event, before *apiv1.EventDetails) {
+	details := &evt.EventDetails
+	action := string(evt.Type)
+
+	switch {
+	case before != nil:
+		tx.audit(action, auditObjects(before, details), before, details)
+	case isRemoval(evt.Type):
+		tx.audit(action, auditObjects(details), details, nil)
+	default:
+		tx.audit(action, auditObjects(details), nil, details)
+	}
+}
+
+// isRemoval tells whether events of the given type describe something
+// that's gone.
+func isRemoval(t apiv1.EventType) bool {
+	switch t {
+	case apiv1.EventRoleDeleted,
+		apiv1.EventRolePermissionRemoved,
+		apiv1.EventRoleIncludeRemoved,
+		apiv1.EventAssignmentRemoved,
+		apiv1.EventPermissionDeleted,
+		apiv1.EventDenyRuleDeleted,
+		apiv1.EventSubjectDeleted,
+		apiv1.EventGroupDeleted,
+		apiv1.EventGroupMemberRemoved,
+		apiv1.EventEffectivePermissionsLapsed:
+		return true
+	default:
+		return false
+	}
+}
+
+// auditObjects returns the IDs of the objects event details are about,
+// which audit entries can be looked up by.
+func auditObjects(details ...*apiv1.EventDetails) []string {
+	objects := []string{}
+	seen := map[string]struct{}{}
+
+	add := func(ids ...string) {
+		for _, id := range ids {
+			if _, ok := seen[id]; ok || id == "" {
+				continue
+			}
+
+			seen[id] = struct{}{}
+			objects = append(objects, id)
+		}
+	}
+
+	for _, d := range details {
+		if d.Role != nil {
+			add(d.Role.Id.String())
+
+			if d.Role.Directory != nil {
+				add(*d.Role.Directory)
+			}
+		}
+
+		if d.IncludedRole != nil {
+			add(d.IncludedRole.Id.String())
+		}
+
+		if d.Permission != nil {
+			add(d.Permission.Target)
+		}
+
+		if a := d.Assignment; a != nil {
+			add(a.Role.String(), a.Subject, a.Scope)
+		}
+
+		if r := d.DenyRule; r != nil {
+			add(r.Id.String(), r.Scope, r.Target)
+
+			if r.Subject != nil {
+				add(*r.Subject)
+			}
+
+			if r.Role != nil {
+				add(r.Role.String())
+			}
+		}
+
+		if d.Subject != nil {
+			add(d.Subject.Id)
+		}
+
+		if d.Group != nil {
+			add(d.Group.Id)
+		}
+
+		if d.Member != nil {
+			add(d.Member.Id)
+		}
+
+		if d.Changes != nil {
+			add(d.Changes.Subject)
+		}
+
+		if m := d.Move; m != nil {
+			add(m.Directory)
+
+			if m.Parent != nil {
+				add(*m.Parent)
+			}
+		}
+	}
+
+	return objects
+}
+
+// writeAudit writes the audited changes to the audit log, in order.
+func (tx *txn) writeAudit(c context.Context) error {
+	actor := null.NewString(tx.actor, tx.actor != "")
+
+	for _, entry := range tx.audited {
+		before, err := auditState(entry.before)
+		if err != nil {
+			return fmt.Errorf("couldn't encode state before %s: %w", entry.action, err)
+		}
+
+		after, err := auditState(entry.after)
+		if err != nil {
+			return fmt.Errorf("couldn't encode state after %s: %w", entry.action, err)
+		}
+
+		logEntry := &models.AuditLog{
+			Actor:   actor,
+			Action:  entry.action,
+			Objects: entry.objects,
+			Before:  before,
+			After:   after,
+		}
+
+		if err := logEntry.Insert(c, tx, boil.Infer()); err != nil {
+			return fmt.Errorf("couldn't audit %s: %w", entry.action, err)
+		}
+	}
+
+	return nil
+}
+
+// auditState encodes the state of objects, which is NULL if they don't
+// exist.
+func auditState(state interface{}) (null.JSON, error) {
+	if state == nil {
+		return null.JSON{}, nil
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return null.JSON{}, err
+	}
+
+	return null.JSONFrom(data), nil
+}
+
+func (drv *sqlDriver) GetAuditEntries(c context.Context, params *apiv1.GetAuditEntriesParams) (*apiv1.AuditLog, error) {
+	mods := []qm.QueryMod{}
+	limit := defaultPageLimit
+
+	if params != nil {
+		if params.Actor != nil {
+			mods = append(mods, models.AuditLogWhere.Actor.EQ(null.StringFrom(*params.Actor)))
+		}
+
+		if params.Object != nil {
+			mods = append(mods, qm.Where("objects @> ARRAY[?::STRING]", *params.Object))
+		}
+
+		if params.Action != nil {
+			mods = append(mods, models.AuditLogWhere.Action.EQ(*params.Action))
+		}
+
+		if params.Since != nil {
+			mods = append(mods, models.AuditLogWhere.CreatedAt.GTE(*params.Since))
+		}
+
+		if params.Until != nil {
+			mods = append(mods, models.AuditLogWhere.CreatedAt.LT(*params.Until))
+		}
+
+		if params.Cursor != nil {
+			id, err := decodeAuditCursor(*params.Cursor)
+			if err != nil {
+				return nil, err
+			}
+
+			mods = append(mods, models.AuditLogWhere.ID.LT(id))
+		}
+
+		if params.Limit != nil && *params.Limit > 0 {
+			limit = *params.Limit
+		}
+	}
+
+	// one more entry than asked for tells whether there's a next page
+	mods = append(mods,
+		qm.OrderBy(models.AuditLogColumns.ID+" DESC"),
+		qm.Limit(limit+1),
+	)
+
+	entries, err := models.AuditLogs(mods...).All(c, drv.db)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get audit entries: %w", dbError(err))
+	}
+
+	auditLog := &apiv1.AuditLog{
+		Entries: []apiv1.AuditEntry{},
+	}
+
+	if len(entries) > limit {
+		entries = entries[:limit]
+		cursor := encodeAuditCursor(entries[limit-1].ID)
+		auditLog.NextCursor = &cursor
+	}
+
+	for _, e := range entries {
+		entry, err := auditLogEntry(e)
+		if err != nil {
+			return nil, err
+		}
+
+		auditLog.Entries = append(auditLog.Entries, *entry)
+	}
+
+	return auditLog, nil
+}
+
+// auditLogEntry converts an audit log model.
+func auditLogEntry(e *models.AuditLog) (*apiv1.AuditEntry, error) {
+	before, err := decodeAuditState(e.Before)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't decode state before audit entry %d: %w", e.ID, err)
+	}
+
+	after, err := decodeAuditState(e.After)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't decode state after audit entry %d: %w", e.ID, err)
+	}
+
+	return &apiv1.AuditEntry{
+		Id:      strconv.FormatInt(e.ID, 10),
+		Time:    e.CreatedAt,
+		Actor:   e.Actor.Ptr(),
+		Action:  e.Action,
+		Objects: e.Objects,
+		Before:  before,
+		After:   after,
+	}, nil
+}
+
+func decodeAuditState(data null.JSON) (*map[string]interface{}, error) {
+	if !data.Valid {
+		return nil, nil
+	}
+
+	state := map[string]interface{}{}
+	if err := data.Unmarshal(&state); err != nil {
+		return nil, err
+	}
+
+	return &state, nil
+}
+
+// encodeAuditCursor returns an opaque cursor to the audit entries older
+// than the given one.
+func encodeAuditCursor(id int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(id, 10)))
+}
+
+func decodeAuditCursor(cursor string) (int64, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", storage.ErrInvalidCursor, cursor)
+	}
+
+	id, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", storage.ErrInvalidCursor, cursor)
+	}
+
+	return id, nil
+}
@@ -0,0 +1,143 @@
+package sql_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+	"github.com/infratographer/lmi/internal/storage"
+)
+
+func TestAuditLog(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	env := newTestEnv(t)
+
+	alice, bob := newSubject(), newSubject()
+	asAlice := storage.WithActor(ctx, alice)
+
+	role, err := env.store.CreateRole(asAlice, apiv1.NewRole{Name: "viewer"})
+	require.NoError(t, err)
+
+	role.Name = "reader"
+
+	_, err = env.store.UpdateRole(asAlice, role, nil)
+	require.NoError(t, err)
+
+	// made without an actor
+	_, err = env.store.CreateRole(ctx, apiv1.NewRole{Name: "editor"})
+	require.NoError(t, err)
+
+	entries := func(t *testing.T, params apiv1.GetAuditEntriesParams) *apiv1.AuditLog {
+		t.Helper()
+
+		auditLog, err := env.store.GetAuditEntries(ctx, &params)
+		require.NoError(t, err)
+
+		return auditLog
+	}
+
+	roleName := func(t *testing.T, state *map[string]interface{}) interface{} {
+		t.Helper()
+
+		require.NotNil(t, state)
+		require.Contains(t, *state, "role")
+
+		return (*state)["role"].(map[string]interface{})["name"]
+	}
+
+	t.Run("changes are audited with their actor", func(t *testing.T) {
+		auditLog := entries(t, apiv1.GetAuditEntriesParams{Actor: &alice})
+		require.Len(t, auditLog.Entries, 2)
+		assert.Nil(t, auditLog.NextCursor)
+
+		for _, e := range auditLog.Entries {
+			assert.Equal(t, &alice, e.Actor)
+			assert.Contains(t, e.Objects, role.Id.String())
+		}
+
+		// newest first
+		assert.Equal(t, string(apiv1.EventRoleUpdated), auditLog.Entries[0].Action)
+		assert.Equal(t, string(apiv1.EventRoleCreated), auditLog.Entries[1].Action)
+
+		action := string(apiv1.EventRoleCreated)
+
+		auditLog = entries(t, apiv1.GetAuditEntriesParams{Action: &action})
+		require.Len(t, auditLog.Entries, 2)
+		assert.Nil(t, auditLog.Entries[0].Actor, "changes made without an actor")
+		assert.Equal(t, &alice, auditLog.Entries[1].Actor)
+	})
+
+	t.Run("entries record the state before and after changes", func(t *testing.T) {
+		created := string(apiv1.EventRoleCreated)
+		object := role.Id.String()
+
+		auditLog := entries(t, apiv1.GetAuditEntriesParams{Object: &object, Action: &created})
+		require.Len(t, auditLog.Entries, 1)
+		assert.Nil(t, auditLog.Entries[0].Before)
+		assert.Equal(t, "viewer", roleName(t, auditLog.Entries[0].After))
+
+		updated := string(apiv1.EventRoleUpdated)
+
+		auditLog = entries(t, apiv1.GetAuditEntriesParams{Object: &object, Action: &updated})
+		require.Len(t, auditLog.Entries, 1)
+		assert.Equal(t, "viewer", roleName(t, auditLog.Entries[0].Before))
+		assert.Equal(t, "reader", roleName(t, auditLog.Entries[0].After))
+	})
+
+	t.Run("filtered entries are paginated", func(t *testing.T) {
+		asBob := storage.WithActor(ctx, bob)
+
+		for _, name := range []string{"a", "b", "c", "d", "e"} {
+			_, err := env.store.CreateRole(asBob, apiv1.NewRole{Name: name})
+			require.NoError(t, err)
+		}
+
+		limit := 2
+		params := apiv1.GetAuditEntriesParams{Actor: &bob, Limit: &limit}
+
+		pages := []int{}
+		seen := map[string]struct{}{}
+		var last int64
+
+		for {
+			auditLog := entries(t, params)
+			pages = append(pages, len(auditLog.Entries))
+
+			for _, e := range auditLog.Entries {
+				assert.Equal(t, &bob, e.Actor)
+				assert.NotContains(t, seen, e.Id)
+
+				id, err := strconv.ParseInt(e.Id, 10, 64)
+				require.NoError(t, err)
+
+				if last != 0 {
+					assert.Less(t, id, last, "newest first")
+				}
+
+				seen[e.Id] = struct{}{}
+				last = id
+			}
+
+			if auditLog.NextCursor == nil {
+				break
+			}
+
+			params.Cursor = auditLog.NextCursor
+		}
+
+		assert.Equal(t, []int{2, 2, 1}, pages)
+	})
+
+	t.Run("invalid cursors are rejected", func(t *testing.T) {
+		cursor := "not a cursor"
+
+		_, err := env.store.GetAuditEntries(ctx, &apiv1.GetAuditEntriesParams{Cursor: &cursor})
+		assert.ErrorIs(t, err, storage.ErrInvalidCursor)
+	})
+}
@@ -0,0 +1,140 @@
+package sql_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+	sqlstore "github.com/infratographer/lmi/internal/storage/sql"
+)
+
+func TestCheckPermission(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	env := newTestEnv(t, sqlstore.WithAutoTrackSubjects(true))
+
+	root := env.addDirectory(ctx, t, nil)
+	child := env.addDirectory(ctx, t, &root)
+
+	roleID := env.createRole(ctx, t, apiv1.NewRole{Name: "instance-creator"}, testTarget)
+	wildcardRole := env.createRole(ctx, t, apiv1.NewRole{Name: "volume-admin"}, "volumes.*")
+
+	subject := newSubject()
+	env.assignRole(ctx, t, roleID, subject, child)
+	env.assignRole(ctx, t, wildcardRole, subject, child)
+
+	t.Run("permissions are granted by the subject's roles", func(t *testing.T) {
+		res := env.check(ctx, t, subject, testTarget, child)
+		assert.True(t, res.Allowed)
+		assert.Equal(t, &roleID, res.Role)
+		assert.Nil(t, res.Deny)
+	})
+
+	t.Run("wildcards grant the targets they match", func(t *testing.T) {
+		res := env.check(ctx, t, subject, "volumes.attach", child)
+		assert.True(t, res.Allowed)
+		assert.Equal(t, &wildcardRole, res.Role)
+
+		assert.False(t, env.check(ctx, t, subject, "volumesnapshots.create", child).Allowed)
+	})
+
+	t.Run("nothing else is granted", func(t *testing.T) {
+		res := env.check(ctx, t, subject, "instances.delete", child)
+		assert.False(t, res.Allowed)
+		assert.Nil(t, res.Role)
+
+		assert.False(t, env.check(ctx, t, newSubject(), testTarget, child).Allowed, "unknown subject")
+	})
+
+	t.Run("permissions are inherited by child directories only", func(t *testing.T) {
+		grandchild := env.addDirectory(ctx, t, &child)
+
+		assert.True(t, env.check(ctx, t, subject, testTarget, grandchild).Allowed)
+		assert.False(t, env.check(ctx, t, subject, testTarget, root).Allowed)
+	})
+
+	t.Run("denies take precedence over grants", func(t *testing.T) {
+		denied := newSubject()
+		env.assignRole(ctx, t, roleID, denied, root)
+
+		rule, err := env.store.CreateDenyRule(ctx, apiv1.NewDenyRule{
+			Subject: &denied,
+			Scope:   child.String(),
+			Target:  "instances.*",
+		})
+		require.NoError(t, err)
+
+		res := env.check(ctx, t, denied, testTarget, child)
+		assert.False(t, res.Allowed)
+		assert.Nil(t, res.Role)
+		require.NotNil(t, res.Deny)
+		assert.Equal(t, rule.Id, res.Deny.Id)
+
+		assert.True(t, env.check(ctx, t, denied, testTarget, root).Allowed, "denies apply below their scope")
+	})
+}
+
+func TestCheckPermissions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	env := newTestEnv(t, sqlstore.WithAutoTrackSubjects(true))
+
+	root := env.addDirectory(ctx, t, nil)
+	child := env.addDirectory(ctx, t, &root)
+
+	roleID := env.createRole(ctx, t, apiv1.NewRole{Name: "instance-creator"}, testTarget)
+
+	alice, bob := newSubject(), newSubject()
+	env.assignRole(ctx, t, roleID, alice, root)
+	env.assignRole(ctx, t, roleID, bob, root)
+
+	rule, err := env.store.CreateDenyRule(ctx, apiv1.NewDenyRule{
+		Subject: &bob,
+		Scope:   child.String(),
+		Target:  testTarget,
+	})
+	require.NoError(t, err)
+
+	t.Run("each check gets a result in order", func(t *testing.T) {
+		results, err := env.store.CheckPermissions(ctx, []apiv1.CheckRequest{
+			{Subject: alice, Target: testTarget, Scope: child.String()},
+			{Subject: alice, Target: "instances.delete", Scope: child.String()},
+			{Subject: bob, Target: testTarget, Scope: child.String()},
+			{Subject: bob, Target: testTarget, Scope: root.String()},
+			{Subject: newSubject(), Target: testTarget, Scope: root.String()},
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 5)
+
+		assert.Equal(t, apiv1.CheckResult{Allowed: true, Role: &roleID}, results[0])
+		assert.Equal(t, apiv1.CheckResult{}, results[1])
+
+		assert.False(t, results[2].Allowed)
+		require.NotNil(t, results[2].Deny)
+		assert.Equal(t, rule.Id, results[2].Deny.Id)
+
+		assert.Equal(t, apiv1.CheckResult{Allowed: true, Role: &roleID}, results[3])
+		assert.Equal(t, apiv1.CheckResult{}, results[4])
+	})
+
+	t.Run("repeated checks get the same result", func(t *testing.T) {
+		req := apiv1.CheckRequest{Subject: alice, Target: testTarget, Scope: child.String()}
+
+		results, err := env.store.CheckPermissions(ctx, []apiv1.CheckRequest{req, req})
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, results[0], results[1])
+		assert.True(t, results[0].Allowed)
+	})
+
+	t.Run("empty batches get no results", func(t *testing.T) {
+		results, err := env.store.CheckPermissions(ctx, nil)
+		require.NoError(t, err)
+		assert.Empty(t, results)
+	})
+}
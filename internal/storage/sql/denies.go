@@ -0,0 +1,304 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+	"github.com/infratographer/lmi/internal/storage"
+	"github.com/infratographer/lmi/internal/storage/sql/models"
+)
+
+// selectCheckResultsQuery checks a batch of requests at once. They're
+// given as parallel arrays, with a row per candidate target of each
+// request, ranked from the target itself to its least specific wildcard.
+// For each request, it returns the role granting its best ranked
+// candidate, if any, and the oldest deny rule matching one of its
+// candidates on the scope or one of its ancestors, either for the subject
+// or a group it's a member of, or for a role the subject holds on the
+// scope.
+var selectCheckResultsQuery = `WITH RECURSIVE candidates (idx, subject_id, target, scope, rank) AS (
+	SELECT * FROM unnest($1::INT8[], $2::STRING[], $3::STRING[], $4::UUID[], $5::INT8[])
+), checks (idx, subject_id, scope) AS (
+	SELECT DISTINCT idx, subject_id, scope FROM candidates
+), ancestors (idx, id) AS (
+	SELECT idx, scope FROM checks
+	UNION
+	SELECT a.idx, dp.parent_id FROM directory_parents dp
+	JOIN ancestors a ON dp.directory_id = a.id
+	WHERE dp.parent_id IS NOT NULL
+), memberships (idx, id) AS (
+	SELECT idx, subject_id FROM checks
+	UNION
+	SELECT m.idx, gm.group_id FROM group_members gm
+	JOIN memberships m ON gm.member_id = m.id
+), held (idx, role_id) AS (
+	SELECT m.idx, ra.role_id FROM role_assignments ra
+	JOIN memberships m ON ra.subject_id = m.id
+	JOIN ancestors a ON a.idx = m.idx AND a.id = ra.scope
+	WHERE ` + activeAssignmentCondition("ra") + `
+	UNION
+	SELECT h.idx, ri.included_role_id FROM role_includes ri
+	JOIN held h ON ri.role_id = h.role_id
+), grants (idx, from_role) AS (
+	SELECT DISTINCT ON (c.idx) c.idx, ep.from_role FROM candidates c
+	JOIN effective_permissions ep
+	ON ep.subject_id = c.subject_id AND ep.target = c.target AND ep.scope = c.scope
+	WHERE ep.expires_at IS NULL OR ep.expires_at > NOW()
+	ORDER BY c.idx, c.rank
+), denies AS (
+	SELECT DISTINCT ON (c.idx) c.idx, dr.* FROM candidates c
+	JOIN deny_rules dr ON dr.target = c.target
+	WHERE EXISTS (SELECT 1 FROM ancestors a WHERE a.idx = c.idx AND a.id = dr.scope)
+	AND (
+		EXISTS (SELECT 1 FROM memberships m WHERE m.idx = c.idx AND m.id = dr.subject_id)
+		OR EXISTS (SELECT 1 FROM held h WHERE h.idx = c.idx AND h.role_id = dr.role_id)
+	)
+	ORDER BY c.idx, dr.created_at, dr.id
+)
+SELECT ch.idx, g.from_role,
+	d.id AS deny_id, d.subject_id AS deny_subject_id, d.role_id AS deny_role_id,
+	d.target AS deny_target, d.scope AS deny_scope, d.reason AS deny_reason,
+	d.created_at AS deny_created_at
+FROM checks ch
+LEFT JOIN grants g ON g.idx = ch.idx
+LEFT JOIN denies d ON d.idx = ch.idx`
+
+// checkResult is the result of a request checked by
+// selectCheckResultsQuery. The deny rule columns are all NULL when no
+// deny rule matches.
+type checkResult struct {
+	Idx           int         `boil:"idx"`
+	FromRole      null.String `boil:"from_role"`
+	DenyID        null.String `boil:"deny_id"`
+	DenySubjectID null.String `boil:"deny_subject_id"`
+	DenyRoleID    null.String `boil:"deny_role_id"`
+	DenyTarget    null.String `boil:"deny_target"`
+	DenyScope     null.String `boil:"deny_scope"`
+	DenyReason    null.String `boil:"deny_reason"`
+	DenyCreatedAt null.Time   `boil:"deny_created_at"`
+}
+
+// denyRule returns the deny rule matching the request, if any.
+func (r *checkResult) denyRule() (*apiv1.DenyRule, error) {
+	if !r.DenyID.Valid {
+		return nil, nil
+	}
+
+	return denyRule(&models.DenyRule{
+		ID:        r.DenyID.String,
+		SubjectID: r.DenySubjectID,
+		RoleID:    r.DenyRoleID,
+		Target:    r.DenyTarget.String,
+		Scope:     r.DenyScope.String,
+		Reason:    r.DenyReason.String,
+		CreatedAt: r.DenyCreatedAt.Time,
+	})
+}
+
+func (drv *sqlDriver) GetDenyRules(c context.Context, params *apiv1.GetDenyRulesParams) ([]*apiv1.DenyRule, error) {
+	mods := []qm.QueryMod{
+		qm.OrderBy(models.DenyRuleColumns.CreatedAt + ", " + models.DenyRuleColumns.ID),
+	}
+
+	if params != nil {
+		if params.Subject != nil {
+			mods = append(mods, models.DenyRuleWhere.SubjectID.EQ(null.StringFrom(*params.Subject)))
+		}
+
+		if params.Role != nil {
+			mods = append(mods, models.DenyRuleWhere.RoleID.EQ(null.StringFrom(params.Role.String())))
+		}
+
+		if params.Scope != nil {
+			mods = append(mods, models.DenyRuleWhere.Scope.EQ(*params.Scope))
+		}
+	}
+
+	drs, err := models.DenyRules(mods...).All(c, drv.db)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get deny rules: %w", dbError(err))
+	}
+
+	rules := make([]*apiv1.DenyRule, len(drs))
+
+	for i, dr := range drs {
+		rules[i], err = denyRule(dr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rules, nil
+}
+
+func (drv *sqlDriver) CreateDenyRule(c context.Context, newRule apiv1.NewDenyRule) (*apiv1.DenyRule, error) {
+	var rule *apiv1.DenyRule
+
+	err := drv.executeTx(c, func(tx *txn) error {
+		exists, err := models.TrackedDirectories(
+			models.TrackedDirectoryWhere.ID.EQ(newRule.Scope),
+		).Exists(c, tx)
+		if err != nil {
+			return fmt.Errorf("couldn't check if directory exists: %w", err)
+		}
+
+		if !exists {
+			return fmt.Errorf("directory %s: %w", newRule.Scope, storage.ErrNotFound)
+		}
+
+		dr := &models.DenyRule{
+			Target: newRule.Target,
+			Scope:  newRule.Scope,
+		}
+
+		if newRule.Subject != nil {
+			if err := drv.ensureSubject(c, tx, *newRule.Subject); err != nil {
+				return err
+			}
+
+			dr.SubjectID = null.StringFrom(*newRule.Subject)
+		}
+
+		if newRule.Role != nil {
+			exists, err := models.RoleExists(c, tx, newRule.Role.String())
+			if err != nil {
+				return fmt.Errorf("couldn't check if role exists: %w", err)
+			}
+
+			if !exists {
+				return fmt.Errorf("role %s: %w", newRule.Role, storage.ErrNotFound)
+			}
+
+			dr.RoleID = null.StringFrom(newRule.Role.String())
+		}
+
+		if newRule.Reason != nil {
+			dr.Reason = *newRule.Reason
+		}
+
+		if err := dr.Insert(c, tx, boil.Infer()); err != nil {
+			return fmt.Errorf("couldn't create deny rule: %w", err)
+		}
+
+		rule, err = denyRule(dr)
+		if err != nil {
+			return err
+		}
+
+		created := rule
+
+		tx.record(apiv1.EventDenyRuleCreated, func(evt *apiv1.Event) {
+			evt.DenyRule = created
+		})
+
+		return refreshDenyRuleEffectivePermissions(c, tx, dr)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+func (drv *sqlDriver) GetDenyRule(c context.Context, id apiv1.EntityID) (*apiv1.DenyRule, error) {
+	dr, err := models.FindDenyRule(c, drv.db, id.String())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, fmt.Errorf("couldn't find deny rule: %w", err)
+	}
+
+	return denyRule(dr)
+}
+
+func (drv *sqlDriver) DeleteDenyRule(c context.Context, id apiv1.EntityID) error {
+	return drv.executeTx(c, func(tx *txn) error {
+		dr, err := models.FindDenyRule(c, tx, id.String())
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return storage.ErrNotFound
+			}
+			return fmt.Errorf("couldn't find deny rule: %w", err)
+		}
+
+		rule, err := denyRule(dr)
+		if err != nil {
+			return err
+		}
+
+		if _, err := dr.Delete(c, tx); err != nil {
+			return fmt.Errorf("couldn't delete deny rule: %w", err)
+		}
+
+		tx.record(apiv1.EventDenyRuleDeleted, func(evt *apiv1.Event) {
+			evt.DenyRule = rule
+		})
+
+		return refreshDenyRuleEffectivePermissions(c, tx, dr)
+	})
+}
+
+// refreshDenyRuleEffectivePermissions recomputes the effective permissions
+// a deny rule affects once it's created or deleted: those of its subject
+// and, for groups, of their transitive members, or those of the subjects
+// holding its role.
+func refreshDenyRuleEffectivePermissions(c context.Context, tx *txn, dr *models.DenyRule) error {
+	if dr.SubjectID.Valid {
+		if err := refreshMembersEffectivePermissions(c, tx, dr.Scope, dr.SubjectID.String); err != nil {
+			return err
+		}
+	}
+
+	if dr.RoleID.Valid {
+		holders, err := selectStrings(c, tx, selectRoleHoldersQuery, dr.RoleID.String)
+		if err != nil {
+			return fmt.Errorf("couldn't get holders of role %s: %w", dr.RoleID.String, err)
+		}
+
+		if err := refreshSubjectsEffectivePermissions(c, tx, dr.Scope, holders); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// denyRule converts a deny rule model.
+func denyRule(dr *models.DenyRule) (*apiv1.DenyRule, error) {
+	id, err := apiv1.ParseEntityID(dr.ID)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't parse deny rule ID %s: %w", dr.ID, err)
+	}
+
+	rule := &apiv1.DenyRule{
+		Id:        id,
+		Subject:   dr.SubjectID.Ptr(),
+		Target:    dr.Target,
+		Scope:     dr.Scope,
+		CreatedAt: dr.CreatedAt,
+	}
+
+	if dr.RoleID.Valid {
+		roleID, err := apiv1.ParseEntityID(dr.RoleID.String)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't parse role ID %s: %w", dr.RoleID.String, err)
+		}
+
+		rule.Role = &roleID
+	}
+
+	if dr.Reason != "" {
+		reason := dr.Reason
+		rule.Reason = &reason
+	}
+
+	return rule, nil
+}
@@ -0,0 +1,223 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	fsv1 "github.com/infratographer/fertilesoil/api/v1"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+	"github.com/infratographer/lmi/internal/storage"
+	"github.com/infratographer/lmi/internal/storage/sql/models"
+)
+
+// Directory changes aren't published as events since they come from
+// fertilesoil, but they're audited as they change effective permissions.
+// Moves are the exception, as the summary of who gained or lost access
+// is only known here.
+const (
+	auditDirectoryAdded   = "directories.added"
+	auditDirectoryRemoved = "directories.removed"
+)
+
+// directoryState is what the audit log records of a directory.
+type directoryState struct {
+	Directory string  `json:"directory"`
+	Parent    *string `json:"parent,omitempty"`
+}
+
+func (drv *sqlDriver) AddDirectory(c context.Context, id fsv1.DirectoryID, parent *fsv1.DirectoryID) error {
+	var parentID null.String
+	if parent != nil {
+		parentID = null.StringFrom(parent.String())
+	}
+
+	return drv.executeTx(c, func(tx *txn) error {
+		_, known, err := directoryParent(c, tx, id)
+		if err != nil {
+			return err
+		}
+
+		dp := &models.DirectoryParent{
+			DirectoryID: id.String(),
+			ParentID:    parentID,
+		}
+
+		if err := dp.Upsert(c, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+			return fmt.Errorf("couldn't track directory %s: %w", id, err)
+		}
+
+		restored, err := models.TrackedDirectories(
+			models.TrackedDirectoryWhere.ID.EQ(id.String()),
+			models.TrackedDirectoryWhere.DeletedAt.IsNotNull(),
+		).UpdateAll(c, tx, models.M{models.TrackedDirectoryColumns.DeletedAt: nil})
+		if err != nil {
+			return fmt.Errorf("couldn't restore directory %s: %w", id, err)
+		}
+
+		// directories are added again whenever they're updated
+		if !known || restored > 0 {
+			tx.audit(auditDirectoryAdded, directoryObjects(id, parentID), nil, directoryState{
+				Directory: id.String(),
+				Parent:    parentID.Ptr(),
+			})
+		}
+
+		return refreshEffectivePermissions(c, tx, id.String())
+	})
+}
+
+func (drv *sqlDriver) RemoveDirectory(c context.Context, id fsv1.DirectoryID) error {
+	return drv.executeTx(c, func(tx *txn) error {
+		// The controller normally soft-deletes the directory before
+		// we're called, but make sure of it for hard deletions.
+		_, err := models.TrackedDirectories(
+			models.TrackedDirectoryWhere.ID.EQ(id.String()),
+			models.TrackedDirectoryWhere.DeletedAt.IsNull(),
+		).UpdateAll(c, tx, models.M{models.TrackedDirectoryColumns.DeletedAt: time.Now()})
+		if err != nil {
+			return fmt.Errorf("couldn't delete directory %s: %w", id, err)
+		}
+
+		tx.audit(auditDirectoryRemoved, []string{id.String()}, directoryState{Directory: id.String()}, nil)
+
+		return refreshEffectivePermissions(c, tx, id.String())
+	})
+}
+
+func (drv *sqlDriver) GetDirectoryParent(c context.Context, id fsv1.DirectoryID) (*fsv1.DirectoryID, error) {
+	parentID, known, err := directoryParent(c, drv.db, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !known {
+		return nil, storage.ErrNotFound
+	}
+
+	if !parentID.Valid {
+		return nil, nil
+	}
+
+	parent, err := fsv1.ParseDirectoryID(parentID.String)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't parse parent ID %s: %w", parentID.String, err)
+	}
+
+	return &parent, nil
+}
+
+func (drv *sqlDriver) MoveDirectory(
+	c context.Context,
+	id fsv1.DirectoryID,
+	parent *fsv1.DirectoryID,
+) (*storage.AccessChanges, error) {
+	var parentID null.String
+	if parent != nil {
+		parentID = null.StringFrom(parent.String())
+	}
+
+	var changes *storage.AccessChanges
+
+	err := drv.executeTx(c, func(tx *txn) error {
+		if parent != nil {
+			cyclic, err := inSubtree(c, tx, id.String(), parent.String())
+			if err != nil {
+				return err
+			}
+
+			if cyclic {
+				return storage.ErrDirectoryCycle
+			}
+		}
+
+		oldParentID, known, err := directoryParent(c, tx, id)
+		if err != nil {
+			return err
+		}
+
+		if !known {
+			return storage.ErrNotFound
+		}
+
+		before, err := subtreeGrants(c, tx, id.String())
+		if err != nil {
+			return err
+		}
+
+		rows, err := models.DirectoryParents(
+			models.DirectoryParentWhere.DirectoryID.EQ(id.String()),
+		).UpdateAll(c, tx, models.M{models.DirectoryParentColumns.ParentID: parentID})
+		if err != nil {
+			return fmt.Errorf("couldn't move directory %s: %w", id, err)
+		}
+
+		if rows == 0 {
+			return storage.ErrNotFound
+		}
+
+		if err := refreshEffectivePermissions(c, tx, id.String()); err != nil {
+			return err
+		}
+
+		after, err := subtreeGrants(c, tx, id.String())
+		if err != nil {
+			return err
+		}
+
+		changes = diffGrants(before, after)
+
+		tx.recordUpdate(apiv1.EventDirectoryMoved,
+			func(evt *apiv1.Event) {
+				evt.Move = &apiv1.DirectoryMove{Directory: id.String(), Parent: oldParentID.Ptr()}
+			},
+			func(evt *apiv1.Event) {
+				evt.Move = &apiv1.DirectoryMove{
+					Directory: id.String(),
+					Parent:    parentID.Ptr(),
+					Gained:    changes.Gained,
+					Lost:      changes.Lost,
+				}
+			})
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// directoryParent returns the parent recorded for a directory, and
+// whether the directory is known at all.
+func directoryParent(c context.Context, exec boil.ContextExecutor, id fsv1.DirectoryID) (null.String, bool, error) {
+	dp, err := models.FindDirectoryParent(c, exec, id.String())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return null.String{}, false, nil
+		}
+		return null.String{}, false, fmt.Errorf("couldn't get parent of directory %s: %w", id, err)
+	}
+
+	return dp.ParentID, true, nil
+}
+
+// directoryObjects returns the IDs of a directory and of its parents, for
+// the audit log.
+func directoryObjects(id fsv1.DirectoryID, parents ...null.String) []string {
+	objects := []string{id.String()}
+
+	for _, p := range parents {
+		if p.Valid {
+			objects = append(objects, p.String)
+		}
+	}
+
+	return objects
+}
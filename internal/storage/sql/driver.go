@@ -6,7 +6,10 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/lib/pq"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 
 	apiv1 "github.com/infratographer/lmi/api/v1"
@@ -15,16 +18,34 @@ import (
 )
 
 type sqlDriver struct {
-	db *sql.DB
+	db                *sql.DB
+	autoTrackSubjects bool
 }
 
 // ensure we implement storage interface.
 var _ storage.Storage = (*sqlDriver)(nil)
 
-func NewSQLDriver(db *sql.DB) storage.Storage {
-	return &sqlDriver{
+// Option configures the SQL driver.
+type Option func(*sqlDriver)
+
+// WithAutoTrackSubjects makes role assignments start tracking unknown
+// subjects, instead of failing with storage.ErrSubjectNotTracked.
+func WithAutoTrackSubjects(enabled bool) Option {
+	return func(drv *sqlDriver) {
+		drv.autoTrackSubjects = enabled
+	}
+}
+
+func NewSQLDriver(db *sql.DB, opts ...Option) storage.Storage {
+	drv := &sqlDriver{
 		db: db,
 	}
+
+	for _, opt := range opts {
+		opt(drv)
+	}
+
+	return drv
 }
 
 func (drv *sqlDriver) GetAssignments(
@@ -33,7 +54,7 @@ func (drv *sqlDriver) GetAssignments(
 ) ([]*apiv1.Assignment, error) {
 	as, err := models.RoleAssignments(buildGetAssignmentsQuery(params)...).All(c, drv.db)
 	if err != nil {
-		return nil, fmt.Errorf("couldn't get role assignments: %w", err)
+		return nil, fmt.Errorf("couldn't get role assignments: %w", dbError(err))
 	}
 
 	assignments := make([]*apiv1.Assignment, len(as))
@@ -44,9 +65,11 @@ func (drv *sqlDriver) GetAssignments(
 		}
 
 		assignments[i] = &apiv1.Assignment{
-			Subject: a.SubjectID,
-			Scope:   a.Scope,
-			Role:    roleID,
+			Subject:   a.SubjectID,
+			Scope:     a.Scope,
+			Role:      roleID,
+			NotBefore: a.NotBefore.Ptr(),
+			ExpiresAt: a.ExpiresAt.Ptr(),
 		}
 	}
 
@@ -78,60 +101,401 @@ func buildGetAssignmentsQuery(params *apiv1.GetAssignmentsParams) []qm.QueryMod
 	return mods
 }
 
+// permissionSortColumns are the columns permissions can be sorted on.
+var permissionSortColumns = map[string]sortColumn{
+	string(apiv1.GetPermissionsParamsSortTarget):    {name: "p.target", typ: "STRING"},
+	string(apiv1.GetPermissionsParamsSortCreatedAt): {name: "p.created_at", typ: "TIMESTAMP"},
+	string(apiv1.GetPermissionsParamsSortUpdatedAt): {name: "p.updated_at", typ: "TIMESTAMP"},
+}
+
+var permissionKeyColumn = sortColumn{name: "p.target", typ: "STRING"}
+
+func permissionSortValue(p *models.Permission, column string) string {
+	switch column {
+	case "p.created_at":
+		return timeSortValue(p.CreatedAt)
+	case "p.updated_at":
+		return timeSortValue(p.UpdatedAt)
+	default:
+		return p.Target
+	}
+}
+
 func (drv *sqlDriver) GetPermissions(
 	c context.Context,
 	params *apiv1.GetPermissionsParams,
-) ([]*apiv1.Permission, error) {
-	perms, err := models.Permissions(buildPermissionsQuery(params)...).All(c, drv.db)
+) (*apiv1.PermissionList, error) {
+	if params == nil {
+		params = &apiv1.GetPermissionsParams{}
+	}
+
+	sort := apiv1.GetPermissionsParamsSortTarget
+	if params.Sort != nil {
+		sort = *params.Sort
+	}
+
+	q, err := newListQuery(string(sort), permissionSortColumns, permissionKeyColumn,
+		params.Order, params.Limit, params.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	q.from = "permissions p"
+
+	if params.Target != nil {
+		q.where("p.target = ?", *params.Target)
+	}
+
+	if params.Prefix != nil {
+		q.whereHasPrefix("p.target", *params.Prefix)
+	}
+
+	return drv.getPermissionList(c, q)
+}
+
+// getPermissionList returns the page of permissions selected by a query.
+func (drv *sqlDriver) getPermissionList(c context.Context, q *listQuery) (*apiv1.PermissionList, error) {
+	query, args := q.sql()
+
+	perms, err := models.Permissions(qm.SQL(query, args...)).All(c, drv.db)
 	if err != nil {
-		return nil, fmt.Errorf("couldn't get permissions: %w", err)
+		return nil, fmt.Errorf("couldn't get permissions: %w", dbError(err))
+	}
+
+	n, more := q.more(len(perms))
+
+	list := &apiv1.PermissionList{
+		Items: make([]apiv1.Permission, n),
 	}
 
-	permissions := make([]*apiv1.Permission, len(perms))
-	for i, p := range perms {
-		permissions[i] = &apiv1.Permission{
+	for i, p := range perms[:n] {
+		list.Items[i] = apiv1.Permission{
 			Target:      p.Target,
 			Description: &p.Description,
 		}
 	}
 
-	return permissions, nil
+	if more {
+		last := perms[n-1]
+		list.NextCursor = q.cursor(permissionSortValue(last, q.column.name), last.Target)
+	}
+
+	return list, nil
 }
 
-func buildPermissionsQuery(params *apiv1.GetPermissionsParams) []qm.QueryMod {
-	mods := []qm.QueryMod{}
-	if params != nil {
-		if params.Target != nil {
-			mods = append(mods, models.PermissionWhere.Target.EQ(*params.Target))
+func (drv *sqlDriver) CreatePermission(c context.Context, perm apiv1.Permission) (*apiv1.Permission, error) {
+	p := &models.Permission{
+		Target: perm.Target,
+	}
+
+	if perm.Description != nil {
+		p.Description = *perm.Description
+	}
+
+	err := drv.executeTx(c, func(tx *txn) error {
+		exists, err := models.PermissionExists(c, tx, p.Target)
+		if err != nil {
+			return fmt.Errorf("couldn't check if permission exists: %w", err)
 		}
+
+		if exists {
+			return fmt.Errorf("permission %s: %w", p.Target, storage.ErrAlreadyExists)
+		}
+
+		if err := p.Insert(c, tx, boil.Infer()); err != nil {
+			return fmt.Errorf("couldn't create permission: %w", err)
+		}
+
+		tx.record(apiv1.EventPermissionCreated, func(evt *apiv1.Event) {
+			evt.Permission = permission(p)
+		})
+
+		return refreshTargetEffectivePermissions(c, tx, p.Target)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return mods
+	return &apiv1.Permission{
+		Target:      p.Target,
+		Description: &p.Description,
+	}, nil
 }
 
-func (drv *sqlDriver) GetRoles(c context.Context) ([]*apiv1.RoleInfo, error) {
-	roles, err := models.Roles().All(c, drv.db)
+func (drv *sqlDriver) UpdatePermission(c context.Context, perm *apiv1.Permission) (*apiv1.Permission, error) {
+	var p *models.Permission
+
+	err := drv.executeTx(c, func(tx *txn) error {
+		var err error
+
+		p, err = models.FindPermission(c, tx, perm.Target)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return storage.ErrNotFound
+			}
+			return fmt.Errorf("couldn't find permission: %w", err)
+		}
+
+		before := permission(p)
+
+		if perm.Description != nil {
+			p.Description = *perm.Description
+		}
+
+		if _, err := p.Update(c, tx, boil.Infer()); err != nil {
+			return fmt.Errorf("couldn't update permission: %w", err)
+		}
+
+		recordPermissionUpdate(tx, before, p)
+
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("couldn't get roles: %w", err)
+		return nil, err
 	}
 
-	rolesOut := make([]*apiv1.RoleInfo, len(roles))
-	for i, r := range roles {
+	return &apiv1.Permission{
+		Target:      p.Target,
+		Description: &p.Description,
+	}, nil
+}
+
+func (drv *sqlDriver) DeletePermission(c context.Context, target string) error {
+	return drv.executeTx(c, func(tx *txn) error {
+		p, err := models.FindPermission(c, tx, target)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return storage.ErrNotFound
+			}
+			return fmt.Errorf("couldn't find permission: %w", err)
+		}
+
+		// Deleting the permission would silently remove it from
+		// every role that grants it, so refuse to do so.
+		inUse, err := p.Roles().Exists(c, tx)
+		if err != nil {
+			return fmt.Errorf("couldn't check if permission is in use: %w", err)
+		}
+
+		if inUse {
+			return fmt.Errorf("permission %s: %w", target, storage.ErrPermissionInUse)
+		}
+
+		// The target may still be granted through a wildcard, in which
+		// case its effective permissions would go with it unrecorded.
+		if err := clearEffectivePermissions(c, tx, deleteTargetEffectivePermissionsQuery, p.Target); err != nil {
+			return err
+		}
+
+		if _, err := p.Delete(c, tx); err != nil {
+			return fmt.Errorf("couldn't delete permission: %w", err)
+		}
+
+		tx.record(apiv1.EventPermissionDeleted, func(evt *apiv1.Event) {
+			evt.Permission = permission(p)
+		})
+
+		return nil
+	})
+}
+
+func (drv *sqlDriver) RegisterServicePermissions(
+	c context.Context,
+	service string,
+	manifest apiv1.ServicePermissionManifest,
+) (*apiv1.ServicePermissionsDiff, error) {
+	var diff *apiv1.ServicePermissionsDiff
+
+	err := drv.executeTx(c, func(tx *txn) error {
+		diff = &apiv1.ServicePermissionsDiff{
+			Added:    []string{},
+			Updated:  []string{},
+			Retired:  []string{},
+			Retained: []string{},
+		}
+
+		// Wildcard targets are granted to roles rather than declared by services, so leave them be.
+		existing, err := models.Permissions(
+			qm.Where(models.PermissionColumns.Target+" LIKE ?", likeEscaper.Replace(apiv1.ServiceNamespace(service))+"%"),
+			qm.And(models.PermissionColumns.Target+" NOT LIKE ?", "%"+apiv1.TargetWildcard),
+		).All(c, tx)
+		if err != nil {
+			return fmt.Errorf("couldn't get permissions for service %s: %w", service, err)
+		}
+
+		current := make(map[string]*models.Permission, len(existing))
+		for _, p := range existing {
+			current[p.Target] = p
+		}
+
+		declared := make(map[string]struct{}, len(manifest.Permissions))
+
+		for _, perm := range manifest.Permissions {
+			target := apiv1.ServiceTarget(service, perm.Target)
+			declared[target] = struct{}{}
+
+			description := ""
+			if perm.Description != nil {
+				description = *perm.Description
+			}
+
+			p, ok := current[target]
+			switch {
+			case !ok:
+				p = &models.Permission{
+					Target:      target,
+					Description: description,
+				}
+
+				if err := p.Insert(c, tx, boil.Infer()); err != nil {
+					return fmt.Errorf("couldn't create permission %s: %w", target, err)
+				}
+
+				tx.record(apiv1.EventPermissionCreated, func(evt *apiv1.Event) {
+					evt.Permission = permission(p)
+				})
+
+				diff.Added = append(diff.Added, target)
+			case p.Description != description:
+				before := permission(p)
+				p.Description = description
+
+				if _, err := p.Update(c, tx, boil.Infer()); err != nil {
+					return fmt.Errorf("couldn't update permission %s: %w", target, err)
+				}
+
+				recordPermissionUpdate(tx, before, p)
+
+				diff.Updated = append(diff.Updated, target)
+			}
+		}
+
+		for _, p := range existing {
+			if _, ok := declared[p.Target]; ok {
+				continue
+			}
+
+			inUse, err := p.Roles().Exists(c, tx)
+			if err != nil {
+				return fmt.Errorf("couldn't check if permission %s is in use: %w", p.Target, err)
+			}
+
+			if inUse {
+				diff.Retained = append(diff.Retained, p.Target)
+				continue
+			}
+
+			if err := clearEffectivePermissions(c, tx, deleteTargetEffectivePermissionsQuery, p.Target); err != nil {
+				return err
+			}
+
+			if _, err := p.Delete(c, tx); err != nil {
+				return fmt.Errorf("couldn't retire permission %s: %w", p.Target, err)
+			}
+
+			tx.record(apiv1.EventPermissionDeleted, func(evt *apiv1.Event) {
+				evt.Permission = permission(p)
+			})
+
+			diff.Retired = append(diff.Retired, p.Target)
+		}
+
+		return refreshTargetEffectivePermissions(c, tx, diff.Added...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return diff, nil
+}
+
+// roleSortColumns are the columns roles can be sorted on.
+var roleSortColumns = map[string]sortColumn{
+	string(apiv1.GetRolesParamsSortName):      {name: "name", typ: "STRING"},
+	string(apiv1.GetRolesParamsSortCreatedAt): {name: "created_at", typ: "TIMESTAMP"},
+	string(apiv1.GetRolesParamsSortUpdatedAt): {name: "updated_at", typ: "TIMESTAMP"},
+}
+
+var roleKeyColumn = sortColumn{name: "id", typ: "UUID"}
+
+func roleSortValue(r *models.Role, column string) string {
+	switch column {
+	case "created_at":
+		return timeSortValue(r.CreatedAt)
+	case "updated_at":
+		return timeSortValue(r.UpdatedAt)
+	default:
+		return r.Name
+	}
+}
+
+func (drv *sqlDriver) GetRoles(c context.Context, params *apiv1.GetRolesParams) (*apiv1.RoleList, error) {
+	if params == nil {
+		params = &apiv1.GetRolesParams{}
+	}
+
+	sort := apiv1.GetRolesParamsSortName
+	if params.Sort != nil {
+		sort = *params.Sort
+	}
+
+	q, err := newListQuery(string(sort), roleSortColumns, roleKeyColumn, params.Order, params.Limit, params.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	q.from = "roles"
+
+	if params.Scope != nil {
+		q.with = "WITH RECURSIVE " + ancestorsCTE
+		q.withArgs = []interface{}{*params.Scope}
+		q.where("(directory_id IS NULL OR directory_id IN (SELECT id FROM ancestors))")
+	}
+
+	if params.Name != nil {
+		q.where("name = ?", *params.Name)
+	}
+
+	if params.Prefix != nil {
+		q.whereHasPrefix("name", *params.Prefix)
+	}
+
+	query, args := q.sql()
+
+	roles, err := models.Roles(qm.SQL(query, args...)).All(c, drv.db)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get roles: %w", dbError(err))
+	}
+
+	n, more := q.more(len(roles))
+
+	list := &apiv1.RoleList{
+		Items: make([]apiv1.RoleInfo, n),
+	}
+
+	for i, r := range roles[:n] {
 		roleID, err := apiv1.ParseEntityID(r.ID)
 		if err != nil {
 			return nil, fmt.Errorf("couldn't parse role ID %s: %w", r.ID, err)
 		}
 
-		rolesOut[i] = &apiv1.RoleInfo{
+		list.Items[i] = apiv1.RoleInfo{
 			Id:          roleID,
 			Name:        r.Name,
 			Description: &r.Description,
+			Directory:   r.DirectoryID.Ptr(),
+			Version:     &r.Version,
 			CreatedAt:   r.CreatedAt,
 			UpdatedAt:   r.UpdatedAt,
 		}
 	}
 
-	return rolesOut, nil
+	if more {
+		last := roles[n-1]
+		list.NextCursor = q.cursor(roleSortValue(last, q.column.name), last.ID)
+	}
+
+	return list, nil
 }
 
 func (drv *sqlDriver) CreateRole(c context.Context, newRole apiv1.NewRole) (*apiv1.Role, error) {
@@ -143,8 +507,36 @@ func (drv *sqlDriver) CreateRole(c context.Context, newRole apiv1.NewRole) (*api
 		r.Description = *newRole.Description
 	}
 
-	if err := r.Insert(c, drv.db, boil.Infer()); err != nil {
-		return nil, fmt.Errorf("couldn't create role: %w", err)
+	if newRole.Directory != nil && *newRole.Directory != "" {
+		r.DirectoryID = null.StringFrom(*newRole.Directory)
+	}
+
+	err := drv.executeTx(c, func(tx *txn) error {
+		if r.DirectoryID.Valid {
+			exists, err := models.TrackedDirectories(
+				models.TrackedDirectoryWhere.ID.EQ(r.DirectoryID.String),
+			).Exists(c, tx)
+			if err != nil {
+				return fmt.Errorf("couldn't check if directory exists: %w", err)
+			}
+
+			if !exists {
+				return fmt.Errorf("directory %s: %w", r.DirectoryID.String, storage.ErrNotFound)
+			}
+		}
+
+		if err := checkRoleName(c, tx, r); err != nil {
+			return err
+		}
+
+		if err := r.Insert(c, tx, boil.Infer()); err != nil {
+			return fmt.Errorf("couldn't create role: %w", err)
+		}
+
+		return recordRole(tx, apiv1.EventRoleCreated, r)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	roleID, err := apiv1.ParseEntityID(r.ID)
@@ -156,27 +548,94 @@ func (drv *sqlDriver) CreateRole(c context.Context, newRole apiv1.NewRole) (*api
 		Id:          roleID,
 		Name:        r.Name,
 		Description: &r.Description,
+		Directory:   r.DirectoryID.Ptr(),
+		Version:     &r.Version,
 		CreatedAt:   r.CreatedAt,
 		UpdatedAt:   r.UpdatedAt,
 	}, nil
 }
 
-func (drv *sqlDriver) DeleteRole(c context.Context, id apiv1.EntityID) error {
-	r, err := models.FindRole(c, drv.db, id.String())
+// checkRoleName ensures no other role in the same directory, or no other
+// global role if the role has no directory, has the role's name.
+func checkRoleName(c context.Context, exec boil.ContextExecutor, r *models.Role) error {
+	mods := []qm.QueryMod{
+		models.RoleWhere.Name.EQ(r.Name),
+		models.RoleWhere.DirectoryID.EQ(r.DirectoryID),
+	}
+
+	if r.ID != "" {
+		mods = append(mods, models.RoleWhere.ID.NEQ(r.ID))
+	}
+
+	taken, err := models.Roles(mods...).Exists(c, exec)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return storage.ErrNotFound
-		}
-		return fmt.Errorf("couldn't find role: %w", err)
+		return fmt.Errorf("couldn't check if role name is taken: %w", err)
 	}
 
-	if _, err := r.Delete(c, drv.db); err != nil {
-		return fmt.Errorf("couldn't delete role: %w", err)
+	if taken {
+		return fmt.Errorf("role %s: %w", r.Name, storage.ErrAlreadyExists)
 	}
 
 	return nil
 }
 
+func (drv *sqlDriver) DeleteRole(c context.Context, id apiv1.EntityID, ifVersion *int64) error {
+	return drv.executeTx(c, func(tx *txn) error {
+		r, err := findRoleAtVersion(c, tx, id, ifVersion)
+		if err != nil {
+			return err
+		}
+
+		// Other roles may grant the same targets as the role on these
+		// scopes, so they're recomputed once the role is gone for the
+		// subjects that held it.
+		scopes, err := roleAssignmentScopes(c, tx, r.ID)
+		if err != nil {
+			return err
+		}
+
+		holders, err := selectStrings(c, tx, selectRoleHoldersQuery, r.ID)
+		if err != nil {
+			return fmt.Errorf("couldn't get holders of role %s: %w", r.ID, err)
+		}
+
+		ras, err := r.RoleAssignments().All(c, tx)
+		if err != nil {
+			return fmt.Errorf("couldn't get role assignments: %w", err)
+		}
+
+		// The cascade would remove the role's effective permissions
+		// too, but without recording the change.
+		if err := clearEffectivePermissions(c, tx, deleteRoleEffectivePermissionsQuery, r.ID); err != nil {
+			return err
+		}
+
+		if _, err := r.Delete(c, tx); err != nil {
+			return fmt.Errorf("couldn't delete role: %w", err)
+		}
+
+		// The role's assignments are removed by the cascade, so their
+		// removal is recorded here.
+		for _, ra := range ras {
+			if err := recordAssignment(tx, apiv1.EventAssignmentRemoved, ra); err != nil {
+				return err
+			}
+		}
+
+		if err := recordRole(tx, apiv1.EventRoleDeleted, r); err != nil {
+			return err
+		}
+
+		for _, scope := range scopes {
+			if err := refreshSubjectsEffectivePermissions(c, tx, scope, holders); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
 func (drv *sqlDriver) GetRole(c context.Context, id apiv1.EntityID) (*apiv1.Role, error) {
 	r, err := models.FindRole(c, drv.db, id.String())
 	if err != nil {
@@ -209,28 +668,51 @@ func (drv *sqlDriver) GetRole(c context.Context, id apiv1.EntityID) (*apiv1.Role
 		Id:          roleID,
 		Name:        r.Name,
 		Description: &r.Description,
+		Directory:   r.DirectoryID.Ptr(),
+		Version:     &r.Version,
 		CreatedAt:   r.CreatedAt,
 		UpdatedAt:   r.UpdatedAt,
 		Permissions: &perms,
 	}, nil
 }
 
-func (drv *sqlDriver) UpdateRole(c context.Context, role *apiv1.Role) (*apiv1.Role, error) {
-	r, err := models.FindRole(c, drv.db, role.Id.String())
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, storage.ErrNotFound
+func (drv *sqlDriver) UpdateRole(c context.Context, role *apiv1.Role, ifVersion *int64) (*apiv1.Role, error) {
+	var r *models.Role
+
+	// The directory a role is defined in can't be changed, since its
+	// assignments may not be valid in another directory.
+	err := drv.executeTx(c, func(tx *txn) error {
+		var err error
+
+		r, err = findRoleAtVersion(c, tx, role.Id, ifVersion)
+		if err != nil {
+			return err
 		}
-		return nil, fmt.Errorf("couldn't find role: %w", err)
-	}
 
-	r.Name = role.Name
-	if role.Description != nil {
-		r.Description = *role.Description
-	}
+		before, err := roleInfo(r)
+		if err != nil {
+			return err
+		}
+
+		r.Name = role.Name
+		if role.Description != nil {
+			r.Description = *role.Description
+		}
+
+		if err := checkRoleName(c, tx, r); err != nil {
+			return err
+		}
+
+		r.Version++
+
+		if _, err := r.Update(c, tx, boil.Infer()); err != nil {
+			return fmt.Errorf("couldn't update role: %w", err)
+		}
 
-	if _, err := r.Update(c, drv.db, boil.Infer()); err != nil {
-		return nil, fmt.Errorf("couldn't update role: %w", err)
+		return recordRoleUpdate(tx, before, r)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	roleID, err := apiv1.ParseEntityID(r.ID)
@@ -242,13 +724,27 @@ func (drv *sqlDriver) UpdateRole(c context.Context, role *apiv1.Role) (*apiv1.Ro
 		Id:          roleID,
 		Name:        r.Name,
 		Description: &r.Description,
+		Directory:   r.DirectoryID.Ptr(),
+		Version:     &r.Version,
 		CreatedAt:   r.CreatedAt,
 		UpdatedAt:   r.UpdatedAt,
 	}, nil
 }
 
 func (drv *sqlDriver) RemoveRoleAssignment(c context.Context, a apiv1.Assignment) error {
-	r, err := models.FindRole(c, drv.db, a.Role.String())
+	return drv.executeTx(c, func(tx *txn) error {
+		if err := removeRoleAssignment(c, tx, a); err != nil {
+			return err
+		}
+
+		return refreshMembersEffectivePermissions(c, tx, a.Scope, a.Subject)
+	})
+}
+
+// removeRoleAssignment removes a role assignment, leaving it to the caller
+// to refresh the effective permissions on its scope.
+func removeRoleAssignment(c context.Context, tx *txn, a apiv1.Assignment) error {
+	r, err := models.FindRole(c, tx, a.Role.String())
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return storage.ErrNotFound
@@ -256,7 +752,10 @@ func (drv *sqlDriver) RemoveRoleAssignment(c context.Context, a apiv1.Assignment
 		return fmt.Errorf("couldn't find role: %w", err)
 	}
 
-	ra, err := r.RoleAssignments().One(c, drv.db)
+	ra, err := r.RoleAssignments(
+		models.RoleAssignmentWhere.SubjectID.EQ(a.Subject),
+		models.RoleAssignmentWhere.Scope.EQ(a.Scope),
+	).One(c, tx)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			err = storage.ErrNotFound
@@ -264,14 +763,53 @@ func (drv *sqlDriver) RemoveRoleAssignment(c context.Context, a apiv1.Assignment
 		return fmt.Errorf("couldn't get role assignment: %w", err)
 	}
 
-	if _, err := ra.Delete(c, drv.db); err != nil {
+	if _, err := ra.Delete(c, tx); err != nil {
 		return fmt.Errorf("couldn't delete role assignment: %w", err)
 	}
 
-	return nil
+	return recordAssignment(tx, apiv1.EventAssignmentRemoved, ra)
 }
 
-func (drv *sqlDriver) GetRoleAssignments(c context.Context, roleID apiv1.EntityID) ([]*apiv1.Assignment, error) {
+// assignmentSortColumns are the columns role assignments can be sorted on.
+var assignmentSortColumns = map[string]sortColumn{
+	string(apiv1.GetRoleAssignmentsParamsSortSubject):   {name: "subject_id", typ: "STRING"},
+	string(apiv1.GetRoleAssignmentsParamsSortScope):     {name: "scope", typ: "UUID"},
+	string(apiv1.GetRoleAssignmentsParamsSortCreatedAt): {name: "created_at", typ: "TIMESTAMP"},
+}
+
+var assignmentKeyColumn = sortColumn{name: "id", typ: "UUID"}
+
+func assignmentSortValue(ra *models.RoleAssignment, column string) string {
+	switch column {
+	case "scope":
+		return ra.Scope
+	case "created_at":
+		return timeSortValue(ra.CreatedAt)
+	default:
+		return ra.SubjectID
+	}
+}
+
+func (drv *sqlDriver) GetRoleAssignments(
+	c context.Context,
+	roleID apiv1.EntityID,
+	params *apiv1.GetRoleAssignmentsParams,
+) (*apiv1.AssignmentList, error) {
+	if params == nil {
+		params = &apiv1.GetRoleAssignmentsParams{}
+	}
+
+	sort := apiv1.GetRoleAssignmentsParamsSortSubject
+	if params.Sort != nil {
+		sort = *params.Sort
+	}
+
+	q, err := newListQuery(string(sort), assignmentSortColumns, assignmentKeyColumn,
+		params.Order, params.Limit, params.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
 	r, err := models.FindRole(c, drv.db, roleID.String())
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -280,96 +818,330 @@ func (drv *sqlDriver) GetRoleAssignments(c context.Context, roleID apiv1.EntityI
 		return nil, fmt.Errorf("couldn't find role: %w", err)
 	}
 
-	// Get role assignments
-	ra, err := r.RoleAssignments().All(c, drv.db)
+	q.from = "role_assignments"
+	q.where("role_id = ?", r.ID)
+
+	if params.Subject != nil {
+		q.where("subject_id = ?", *params.Subject)
+	}
+
+	if params.Prefix != nil {
+		q.whereHasPrefix("subject_id", *params.Prefix)
+	}
+
+	if params.Scope != nil {
+		q.where("scope = ?", *params.Scope)
+	}
+
+	query, args := q.sql()
+
+	ra, err := models.RoleAssignments(qm.SQL(query, args...)).All(c, drv.db)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			err = storage.ErrNotFound
-		}
-		return nil, fmt.Errorf("couldn't get role assignments: %w", err)
+		return nil, fmt.Errorf("couldn't get role assignments: %w", dbError(err))
 	}
 
-	assignments := make([]*apiv1.Assignment, len(ra))
-	for i, a := range ra {
-		assignments[i] = &apiv1.Assignment{
-			Role:    roleID,
-			Subject: a.SubjectID,
-			Scope:   a.Scope,
+	n, more := q.more(len(ra))
+
+	list := &apiv1.AssignmentList{
+		Items: make([]apiv1.Assignment, n),
+	}
+
+	for i, a := range ra[:n] {
+		list.Items[i] = apiv1.Assignment{
+			Role:      roleID,
+			Subject:   a.SubjectID,
+			Scope:     a.Scope,
+			NotBefore: a.NotBefore.Ptr(),
+			ExpiresAt: a.ExpiresAt.Ptr(),
 		}
 	}
 
-	return assignments, nil
+	if more {
+		last := ra[n-1]
+		list.NextCursor = q.cursor(assignmentSortValue(last, q.column.name), last.ID)
+	}
+
+	return list, nil
 }
 
 func (drv *sqlDriver) AssignRole(c context.Context, roleID apiv1.EntityID, assignment apiv1.NewRoleAssignment) error {
-	r, err := models.FindRole(c, drv.db, roleID.String())
+	return drv.executeTx(c, func(tx *txn) error {
+		changed, err := drv.assignRole(c, tx, roleID, assignment)
+		if err != nil || !changed {
+			return err
+		}
+
+		return refreshMembersEffectivePermissions(c, tx, assignment.Scope, assignment.Subject)
+	})
+}
+
+// assignRole assigns a role, telling whether that changed anything. It
+// leaves it to the caller to refresh the effective permissions on the
+// scope of the assignment.
+func (drv *sqlDriver) assignRole(
+	c context.Context,
+	tx *txn,
+	roleID apiv1.EntityID,
+	assignment apiv1.NewRoleAssignment,
+) (bool, error) {
+	r, err := models.FindRole(c, tx, roleID.String())
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return storage.ErrNotFound
+			return false, storage.ErrNotFound
 		}
-		return fmt.Errorf("couldn't find role: %w", err)
+		return false, fmt.Errorf("couldn't find role: %w", err)
 	}
 
+	notBefore := null.TimeFromPtr(assignment.NotBefore)
+	expiresAt := null.TimeFromPtr(assignment.ExpiresAt)
+
 	// verify if role assignment already exists
-	exists, err := r.RoleAssignments(
+	existing, err := r.RoleAssignments(
 		qm.Where(models.RoleAssignmentColumns.SubjectID+"=?", assignment.Subject),
 		qm.And(models.RoleAssignmentColumns.Scope+"=?", assignment.Scope),
-	).Exists(c, drv.db)
-	if err != nil {
-		return fmt.Errorf("couldn't check if role assignment exists: %w", err)
+	).One(c, tx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return false, fmt.Errorf("couldn't check if role assignment exists: %w", err)
 	}
 
-	if exists {
-		return nil
+	// assigning the role again only changes when the assignment is active
+	if existing != nil {
+		if sameTime(existing.NotBefore, notBefore) && sameTime(existing.ExpiresAt, expiresAt) {
+			return false, nil
+		}
+
+		before, err := roleAssignment(existing)
+		if err != nil {
+			return false, err
+		}
+
+		existing.NotBefore = notBefore
+		existing.ExpiresAt = expiresAt
+
+		if _, err := existing.Update(c, tx, boil.Infer()); err != nil {
+			return false, fmt.Errorf("couldn't update role assignment: %w", err)
+		}
+
+		return true, recordAssignmentUpdate(tx, before, existing)
+	}
+
+	if r.DirectoryID.Valid {
+		ok, err := inSubtree(c, tx, r.DirectoryID.String, assignment.Scope)
+		if err != nil {
+			return false, err
+		}
+
+		if !ok {
+			return false, fmt.Errorf("role %s can't be assigned on %s: %w", r.ID, assignment.Scope, storage.ErrRoleNotInScope)
+		}
+	}
+
+	if err := drv.ensureSubject(c, tx, assignment.Subject); err != nil {
+		return false, err
 	}
 
 	ra := models.RoleAssignment{
 		RoleID:    r.ID,
 		SubjectID: assignment.Subject,
 		Scope:     assignment.Scope,
+		NotBefore: notBefore,
+		ExpiresAt: expiresAt,
 	}
 
-	err = ra.Insert(c, drv.db, boil.Infer())
+	err = ra.Insert(c, tx, boil.Infer())
 	if err != nil {
-		return fmt.Errorf("couldn't create role assignment: %w", err)
+		return false, fmt.Errorf("couldn't create role assignment: %w", err)
 	}
 
-	return nil
+	return true, recordAssignment(tx, apiv1.EventAssignmentCreated, &ra)
+}
+
+// errAssignmentsRolledBack rolls back an atomic batch of assignment
+// changes of which some failed.
+var errAssignmentsRolledBack = errors.New("assignment changes rolled back")
+
+func (drv *sqlDriver) ChangeAssignments(
+	c context.Context,
+	changes []apiv1.AssignmentChange,
+	atomic bool,
+) ([]error, error) {
+	var results []error
+
+	err := drv.executeTx(c, func(tx *txn) error {
+		results = make([]error, len(changes))
+
+		var (
+			failed bool
+			scopes []string
+		)
+
+		// subjects changed on each scope
+		refresh := map[string][]string{}
+
+		for i, change := range changes {
+			change := change
+
+			var changed bool
+
+			err := tx.savepoint(c, func() error {
+				var err error
+
+				changed, err = drv.changeAssignment(c, tx, change)
+
+				return err
+			})
+			if err != nil {
+				if storage.Kind(err) == nil {
+					return err
+				}
+
+				results[i] = err
+				failed = true
+
+				continue
+			}
+
+			if !changed {
+				continue
+			}
+
+			if _, ok := refresh[change.Scope]; !ok {
+				scopes = append(scopes, change.Scope)
+			}
+
+			refresh[change.Scope] = append(refresh[change.Scope], change.Subject)
+		}
+
+		if atomic && failed {
+			return errAssignmentsRolledBack
+		}
+
+		// the effective permissions are refreshed once per scope rather
+		// than after every change
+		for _, scope := range scopes {
+			if err := refreshMembersEffectivePermissions(c, tx, scope, refresh[scope]...); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil && !errors.Is(err, errAssignmentsRolledBack) {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// changeAssignment applies an assignment change, telling whether that
+// changed anything.
+func (drv *sqlDriver) changeAssignment(c context.Context, tx *txn, change apiv1.AssignmentChange) (bool, error) {
+	switch change.Op {
+	case apiv1.Assign:
+		return drv.assignRole(c, tx, change.Role, apiv1.NewRoleAssignment{
+			Subject:   change.Subject,
+			Scope:     change.Scope,
+			NotBefore: change.NotBefore,
+			ExpiresAt: change.ExpiresAt,
+		})
+	case apiv1.Unassign:
+		err := removeRoleAssignment(c, tx, apiv1.Assignment{
+			Role:    change.Role,
+			Subject: change.Subject,
+			Scope:   change.Scope,
+		})
+
+		return err == nil, err
+	default:
+		return false, fmt.Errorf("%w: unknown assignment change %s", storage.ErrInvalidArgument, change.Op)
+	}
+}
+
+func sameTime(a, b null.Time) bool {
+	if !a.Valid || !b.Valid {
+		return a.Valid == b.Valid
+	}
+
+	return a.Time.Equal(b.Time)
 }
 
 func (drv *sqlDriver) RemoveRolePermission(
 	c context.Context,
 	id apiv1.EntityID,
 	targetID apiv1.PermissionIdentifier,
-) error {
-	r, err := models.FindRole(c, drv.db, id.String())
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return storage.ErrNotFound
+	ifVersion *int64,
+) (int64, error) {
+	var version int64
+
+	err := drv.executeTx(c, func(tx *txn) error {
+		r, err := findRoleAtVersion(c, tx, id, ifVersion)
+		if err != nil {
+			return err
 		}
-		return fmt.Errorf("couldn't find role: %w", err)
-	}
 
-	// get permission
-	p, err := r.TargetPermissions(
-		qm.Where(models.PermissionColumns.Target+"=?", targetID.Target),
-	).One(c, drv.db)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return storage.ErrNotFound
+		// get permission
+		p, err := r.TargetPermissions(
+			qm.Where(models.PermissionColumns.Target+"=?", targetID.Target),
+		).One(c, tx)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return storage.ErrNotFound
+			}
+			return fmt.Errorf("couldn't get role permission: %w", err)
+		}
+
+		err = r.RemoveTargetPermissions(c, tx, p)
+		if err != nil {
+			return fmt.Errorf("couldn't remove role permission: %w", err)
 		}
-		return fmt.Errorf("couldn't get role permission: %w", err)
-	}
 
-	err = r.RemoveTargetPermissions(c, drv.db, p)
+		if err := bumpRoleVersion(c, tx, r); err != nil {
+			return err
+		}
+
+		version = r.Version
+
+		if err := recordRolePermission(tx, apiv1.EventRolePermissionRemoved, r, p); err != nil {
+			return err
+		}
+
+		return refreshRoleEffectivePermissions(c, tx, r.ID)
+	})
 	if err != nil {
-		return fmt.Errorf("couldn't remove role permission: %w", err)
+		return 0, err
 	}
 
-	return nil
+	return version, nil
 }
 
-func (drv *sqlDriver) GetRolePermissions(c context.Context, id apiv1.EntityID) ([]*apiv1.Permission, error) {
+// rolePermissionSortColumns are the columns the permissions of roles can
+// be sorted on.
+var rolePermissionSortColumns = map[string]sortColumn{
+	string(apiv1.GetRolePermissionsParamsSortTarget):    permissionSortColumns[string(apiv1.GetPermissionsParamsSortTarget)],
+	string(apiv1.GetRolePermissionsParamsSortCreatedAt): permissionSortColumns[string(apiv1.GetPermissionsParamsSortCreatedAt)],
+	string(apiv1.GetRolePermissionsParamsSortUpdatedAt): permissionSortColumns[string(apiv1.GetPermissionsParamsSortUpdatedAt)],
+}
+
+func (drv *sqlDriver) GetRolePermissions(
+	c context.Context,
+	id apiv1.EntityID,
+	params *apiv1.GetRolePermissionsParams,
+) (*apiv1.PermissionList, error) {
+	if params == nil {
+		params = &apiv1.GetRolePermissionsParams{}
+	}
+
+	sort := apiv1.GetRolePermissionsParamsSortTarget
+	if params.Sort != nil {
+		sort = *params.Sort
+	}
+
+	q, err := newListQuery(string(sort), rolePermissionSortColumns, permissionKeyColumn,
+		params.Order, params.Limit, params.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
 	r, err := models.FindRole(c, drv.db, id.String())
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -378,62 +1150,208 @@ func (drv *sqlDriver) GetRolePermissions(c context.Context, id apiv1.EntityID) (
 		return nil, fmt.Errorf("couldn't find role: %w", err)
 	}
 
-	// get permissions
-	tp, err := r.TargetPermissions().All(c, drv.db)
-	if err != nil && !errors.Is(err, sql.ErrNoRows) {
-		return nil, fmt.Errorf("couldn't get role permissions: %w", err)
+	q.from = "permissions p JOIN role_permissions rp ON rp.target = p.target"
+
+	// get permissions, including the ones granted through included roles if asked to
+	if params.Expand != nil && *params.Expand {
+		q.with = "WITH RECURSIVE " + includedCTE
+		q.withArgs = []interface{}{r.ID}
+		q.columns = "DISTINCT p.*"
+		q.where("rp.role_id IN (SELECT id FROM included)")
+	} else {
+		q.columns = "p.*"
+		q.where("rp.role_id = ?", r.ID)
 	}
 
-	perms := make([]*apiv1.Permission, len(tp))
-	for i, p := range tp {
-		perms[i] = &apiv1.Permission{
-			Target:      p.Target,
-			Description: &p.Description,
-		}
+	if params.Prefix != nil {
+		q.whereHasPrefix("p.target", *params.Prefix)
 	}
 
-	return perms, nil
+	return drv.getPermissionList(c, q)
 }
 
 func (drv *sqlDriver) AddRolePermission(
 	c context.Context,
 	id apiv1.EntityID,
 	targetID apiv1.PermissionIdentifier,
-) error {
-	r, err := models.FindRole(c, drv.db, id.String())
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return storage.ErrNotFound
+	ifVersion *int64,
+) (int64, error) {
+	var version int64
+
+	err := drv.executeTx(c, func(tx *txn) error {
+		r, err := findRoleAtVersion(c, tx, id, ifVersion)
+		if err != nil {
+			return err
 		}
-		return fmt.Errorf("couldn't find role: %w", err)
+
+		version = r.Version
+
+		// Get permission. Wildcards don't need to be registered up front.
+		perm, err := models.FindPermission(c, tx, targetID.Target)
+		if err != nil {
+			if !errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("couldn't find permission: %w", err)
+			}
+
+			if !apiv1.IsWildcardTarget(targetID.Target) {
+				return fmt.Errorf("couldn't find permission: %w", storage.ErrNotFound)
+			}
+
+			perm = &models.Permission{Target: targetID.Target}
+
+			if err := perm.Insert(c, tx, boil.Infer()); err != nil {
+				return fmt.Errorf("couldn't create wildcard permission: %w", err)
+			}
+
+			tx.record(apiv1.EventPermissionCreated, func(evt *apiv1.Event) {
+				evt.Permission = permission(perm)
+			})
+		}
+
+		// check if role already has permission
+		permExists, err := r.TargetPermissions(
+			qm.Where(models.PermissionColumns.Target+"=?", targetID.Target),
+		).Exists(c, tx)
+		if err != nil {
+			return fmt.Errorf("couldn't check if role has permission: %w", err)
+		}
+
+		if permExists {
+			return nil
+		}
+
+		// add permission to role
+		err = r.AddTargetPermissions(c, tx, false, perm)
+		if err != nil {
+			return fmt.Errorf("couldn't add permission to role: %w", err)
+		}
+
+		if err := bumpRoleVersion(c, tx, r); err != nil {
+			return err
+		}
+
+		version = r.Version
+
+		if err := recordRolePermission(tx, apiv1.EventRolePermissionAdded, r, perm); err != nil {
+			return err
+		}
+
+		return refreshRoleEffectivePermissions(c, tx, r.ID)
+	})
+	if err != nil {
+		return 0, err
 	}
 
-	// Get permission
-	perm, err := models.FindPermission(c, drv.db, targetID.Target)
+	return version, nil
+}
+
+// findRoleAtVersion returns a role, making sure it's at the given version
+// if any.
+func findRoleAtVersion(c context.Context, exec boil.ContextExecutor, id apiv1.EntityID, version *int64) (*models.Role, error) {
+	r, err := models.FindRole(c, exec, id.String())
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			err = storage.ErrNotFound
+			return nil, storage.ErrNotFound
 		}
-		return fmt.Errorf("couldn't find permission: %w", err)
+		return nil, fmt.Errorf("couldn't find role: %w", err)
+	}
+
+	if version != nil && *version != r.Version {
+		return nil, fmt.Errorf("role %s is at version %d, not %d: %w", r.ID, r.Version, *version, storage.ErrVersionMismatch)
+	}
+
+	return r, nil
+}
+
+// bumpRoleVersion increments the version of a role whose permissions
+// changed.
+func bumpRoleVersion(c context.Context, exec boil.ContextExecutor, r *models.Role) error {
+	r.Version++
+
+	if _, err := r.Update(c, exec, boil.Whitelist(models.RoleColumns.Version, models.RoleColumns.UpdatedAt)); err != nil {
+		return fmt.Errorf("couldn't update role version: %w", err)
 	}
 
-	// check if role already has permission
-	permExists, err := r.TargetPermissions(
-		qm.Where(models.PermissionColumns.Target+"=?", targetID.Target),
-	).Exists(c, drv.db)
+	return nil
+}
+
+func (drv *sqlDriver) CheckPermission(c context.Context, req apiv1.CheckRequest) (*apiv1.CheckResult, error) {
+	results, err := drv.CheckPermissions(c, []apiv1.CheckRequest{req})
 	if err != nil {
-		return fmt.Errorf("couldn't check if role has permission: %w", err)
+		return nil, err
 	}
 
-	if permExists {
-		return nil
+	return &results[0], nil
+}
+
+func (drv *sqlDriver) CheckPermissions(c context.Context, reqs []apiv1.CheckRequest) ([]apiv1.CheckResult, error) {
+	results := make([]apiv1.CheckResult, len(reqs))
+	if len(reqs) == 0 {
+		return results, nil
 	}
 
-	// add permission to role
-	err = r.AddTargetPermissions(c, drv.db, true, perm)
+	// Targets that aren't registered yet have no effective permissions,
+	// but they may still be granted by a wildcard. Candidates go from the
+	// target itself to the least specific wildcard.
+	var (
+		idxs, ranks               []int64
+		subjects, targets, scopes []string
+	)
+
+	for i, req := range reqs {
+		candidates := append([]string{req.Target}, apiv1.TargetWildcards(req.Target)...)
+
+		for rank, target := range candidates {
+			idxs = append(idxs, int64(i))
+			subjects = append(subjects, req.Subject)
+			targets = append(targets, target)
+			scopes = append(scopes, req.Scope)
+			ranks = append(ranks, int64(rank))
+		}
+	}
+
+	// Grants and denies are checked in a single query, so they're read
+	// from the same snapshot. Lapsed permissions are ignored until
+	// they're swept.
+	var rows []*checkResult
+
+	err := queries.Raw(selectCheckResultsQuery,
+		pq.Array(idxs), pq.Array(subjects), pq.Array(targets), pq.Array(scopes), pq.Array(ranks),
+	).Bind(c, drv.db, &rows)
 	if err != nil {
-		return fmt.Errorf("couldn't add permission to role: %w", err)
+		return nil, fmt.Errorf("couldn't check effective permissions: %w", dbError(err))
 	}
 
-	return nil
+	for _, row := range rows {
+		// denies take precedence over whatever the subject was granted
+		deny, err := row.denyRule()
+		if err != nil {
+			return nil, err
+		}
+
+		if deny != nil {
+			results[row.Idx] = apiv1.CheckResult{
+				Allowed: false,
+				Deny:    deny,
+			}
+
+			continue
+		}
+
+		if !row.FromRole.Valid {
+			continue
+		}
+
+		roleID, err := apiv1.ParseEntityID(row.FromRole.String)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't parse role ID %s: %w", row.FromRole.String, err)
+		}
+
+		results[row.Idx] = apiv1.CheckResult{
+			Allowed: true,
+			Role:    &roleID,
+		}
+	}
+
+	return results, nil
 }
@@ -0,0 +1,592 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/lib/pq"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+	"github.com/infratographer/lmi/internal/storage"
+)
+
+// subtreeCTE selects the given directory and all of its descendants.
+const subtreeCTE = `subtree (id) AS (
+	SELECT $1::UUID
+	UNION ALL
+	SELECT dp.directory_id FROM directory_parents dp
+	JOIN subtree s ON dp.parent_id = s.id
+)`
+
+// ancestryCTE pairs every directory in the subtree with itself and
+// each of its ancestors. It depends on subtreeCTE.
+const ancestryCTE = `ancestry (id, ancestor_id) AS (
+	SELECT id, id FROM subtree
+	UNION ALL
+	SELECT a.id, dp.parent_id FROM ancestry a
+	JOIN directory_parents dp ON dp.directory_id = a.ancestor_id
+	WHERE dp.parent_id IS NOT NULL
+)`
+
+// ancestorsCTE selects the given directory and all of its ancestors.
+const ancestorsCTE = `ancestors (id) AS (
+	SELECT $1::UUID
+	UNION ALL
+	SELECT dp.parent_id FROM directory_parents dp
+	JOIN ancestors a ON dp.directory_id = a.id
+	WHERE dp.parent_id IS NOT NULL
+)`
+
+// roleClosureCTE pairs every assigned role with itself and with each
+// of the roles it includes, directly or not.
+const roleClosureCTE = `role_closure (role_id, member_id) AS (
+	SELECT DISTINCT role_id, role_id FROM role_assignments
+	UNION
+	SELECT rc.role_id, ri.included_role_id FROM role_closure rc
+	JOIN role_includes ri ON ri.role_id = rc.member_id
+)`
+
+// subjectClosureCTE pairs every subject with a role assignment or a deny
+// rule with itself and, for groups, with each of their members, directly
+// or through nested groups.
+const subjectClosureCTE = `subject_closure (group_id, member_id) AS (
+	SELECT s.subject_id, s.subject_id FROM (
+		SELECT subject_id FROM role_assignments
+		UNION
+		SELECT subject_id FROM deny_rules WHERE subject_id IS NOT NULL
+	) AS s
+	UNION
+	SELECT sc.group_id, gm.member_id FROM subject_closure sc
+	JOIN group_members gm ON gm.group_id = sc.member_id
+)`
+
+// membersCTE selects the given subject and, for groups, each of their
+// members, directly or through nested groups.
+const membersCTE = `members (id) AS (
+	SELECT $1::STRING
+	UNION
+	SELECT gm.member_id FROM group_members gm
+	JOIN members m ON gm.group_id = m.id
+)`
+
+// membershipsCTE selects the given subject and every group it's a member
+// of, directly or through nested groups.
+const membershipsCTE = `memberships (id) AS (
+	SELECT $1::STRING
+	UNION
+	SELECT gm.group_id FROM group_members gm
+	JOIN memberships m ON gm.member_id = m.id
+)`
+
+// subjectsCondition restricts a refresh to the subjects given as $2,
+// unless it's NULL.
+func subjectsCondition(subject string) string {
+	return `($2::STRING[] IS NULL OR ` + subject + ` = ANY($2::STRING[]))`
+}
+
+// includersCTE selects the given role and every role including it,
+// directly or not.
+const includersCTE = `includers (id) AS (
+	SELECT $1::UUID
+	UNION
+	SELECT ri.role_id FROM role_includes ri
+	JOIN includers i ON ri.included_role_id = i.id
+)`
+
+// includedCTE selects the given role and every role it includes,
+// directly or not.
+const includedCTE = `included (id) AS (
+	SELECT $1::UUID
+	UNION
+	SELECT ri.included_role_id FROM role_includes ri
+	JOIN included i ON ri.role_id = i.id
+)`
+
+// targetMatchCondition matches the targets covered by a pattern column:
+// the pattern itself and, for wildcards, every target under the wildcard's
+// prefix, e.g. compute.instances.create for compute.*.
+func targetMatchCondition(pattern, target string) string {
+	return `(` + target + ` = ` + pattern + ` OR (
+	` + pattern + ` LIKE '%*'
+	AND ` + target + ` NOT LIKE '%*'
+	AND left(` + target + `, length(` + pattern + `) - 1) = left(` + pattern + `, length(` + pattern + `) - 1)
+))`
+}
+
+// activeAssignmentCondition matches the role assignments that are
+// active now, given the alias of the role_assignments table.
+func activeAssignmentCondition(ra string) string {
+	return `(` + ra + `.not_before IS NULL OR ` + ra + `.not_before <= NOW())
+	AND (` + ra + `.expires_at IS NULL OR ` + ra + `.expires_at > NOW())`
+}
+
+// noExpiry sorts assignments that never expire after the ones that do.
+const noExpiry = `'9999-12-31'::TIMESTAMPTZ`
+
+// grantColumns are returned by the queries changing effective
+// permissions, so the changes can be published.
+const grantColumns = "subject_id, target, scope, from_role"
+
+var deleteSubtreeEffectivePermissionsQuery = `WITH RECURSIVE ` + subtreeCTE + `
+DELETE FROM effective_permissions WHERE scope IN (SELECT id FROM subtree)
+AND ` + subjectsCondition("subject_id") + `
+RETURNING ` + grantColumns
+
+var insertSubtreeEffectivePermissionsQuery = `WITH RECURSIVE ` +
+	subtreeCTE + `, ` + ancestryCTE + `, ` + roleClosureCTE + `, ` + subjectClosureCTE + `
+INSERT INTO effective_permissions (subject_id, target, scope, from_role, expires_at)
+SELECT sc.member_id, p.target, a.id,
+	(array_agg(ra.role_id ORDER BY COALESCE(ra.expires_at, ` + noExpiry + `) DESC))[1],
+	NULLIF(max(COALESCE(ra.expires_at, ` + noExpiry + `)), ` + noExpiry + `)
+FROM ancestry a
+JOIN role_assignments ra ON ra.scope = a.ancestor_id
+JOIN subject_closure sc ON sc.group_id = ra.subject_id
+JOIN role_closure rc ON rc.role_id = ra.role_id
+JOIN role_permissions rp ON rp.role_id = rc.member_id
+JOIN permissions p ON ` + targetMatchCondition("rp.target", "p.target") + `
+JOIN roles r ON r.id = ra.role_id
+WHERE ` + activeAssignmentCondition("ra") + `
+AND ` + subjectsCondition("sc.member_id") + `
+AND (r.directory_id IS NULL OR EXISTS (
+	SELECT 1 FROM ancestry rd WHERE rd.id = a.id AND rd.ancestor_id = r.directory_id
+))
+AND a.id NOT IN (
+	SELECT d.id FROM ancestry d
+	JOIN tracked_directories td ON td.id = d.ancestor_id
+	WHERE td.deleted_at IS NOT NULL
+)
+GROUP BY sc.member_id, p.target, a.id
+ON CONFLICT DO NOTHING
+RETURNING ` + grantColumns
+
+// deleteDeniedSubtreeEffectivePermissionsQuery removes the effective
+// permissions blocked by a deny rule on the directory or an ancestor,
+// either for the subject or a group it's a member of, or for a role the
+// subject holds there.
+var deleteDeniedSubtreeEffectivePermissionsQuery = `WITH RECURSIVE ` +
+	subtreeCTE + `, ` + ancestryCTE + `, ` + roleClosureCTE + `, ` + subjectClosureCTE + `
+DELETE FROM effective_permissions AS ep
+WHERE ep.scope IN (SELECT id FROM subtree)
+AND ` + subjectsCondition("ep.subject_id") + `
+AND EXISTS (
+	SELECT 1 FROM deny_rules d
+	JOIN ancestry a ON a.ancestor_id = d.scope AND a.id = ep.scope
+	WHERE ` + targetMatchCondition("d.target", "ep.target") + `
+	AND (d.subject_id IN (
+		SELECT sc.group_id FROM subject_closure sc WHERE sc.member_id = ep.subject_id
+	) OR d.role_id IN (
+		SELECT rc.member_id FROM role_assignments ra
+		JOIN ancestry ra_a ON ra_a.ancestor_id = ra.scope AND ra_a.id = ep.scope
+		JOIN role_closure rc ON rc.role_id = ra.role_id
+		WHERE ra.subject_id IN (
+			SELECT sc.group_id FROM subject_closure sc WHERE sc.member_id = ep.subject_id
+		)
+		AND ` + activeAssignmentCondition("ra") + `
+	))
+)
+RETURNING ` + grantColumns
+
+const selectSubtreeEffectivePermissionsQuery = `WITH RECURSIVE ` + subtreeCTE + `
+SELECT subject_id, target, scope FROM effective_permissions WHERE scope IN (SELECT id FROM subtree)`
+
+const selectIncludersAssignmentScopesQuery = `WITH RECURSIVE ` + includersCTE + `
+SELECT DISTINCT scope FROM role_assignments WHERE role_id IN (SELECT id FROM includers)`
+
+// deleteLapsedEffectivePermissionsQuery removes the effective permissions
+// whose granting assignments have all expired.
+const deleteLapsedEffectivePermissionsQuery = `DELETE FROM effective_permissions
+WHERE expires_at IS NOT NULL AND expires_at <= NOW()
+RETURNING subject_id, target, scope, from_role`
+
+// selectActivatedAssignmentScopesQuery selects the scopes of the role
+// assignments that became active after the given time.
+const selectActivatedAssignmentScopesQuery = `SELECT DISTINCT scope FROM role_assignments
+WHERE not_before > $1 AND not_before <= NOW()`
+
+// deleteRoleEffectivePermissionsQuery removes the effective permissions
+// granted by a role.
+const deleteRoleEffectivePermissionsQuery = `DELETE FROM effective_permissions
+WHERE from_role = $1
+RETURNING ` + grantColumns
+
+// deleteSubjectEffectivePermissionsQuery removes the effective permissions
+// of a subject.
+const deleteSubjectEffectivePermissionsQuery = `DELETE FROM effective_permissions
+WHERE subject_id = $1
+RETURNING ` + grantColumns
+
+// deleteTargetEffectivePermissionsQuery removes the effective permissions
+// for a target.
+const deleteTargetEffectivePermissionsQuery = `DELETE FROM effective_permissions
+WHERE target = $1
+RETURNING ` + grantColumns
+
+// selectMembersQuery selects the subject and, for groups, all of their
+// transitive members.
+const selectMembersQuery = `WITH RECURSIVE ` + membersCTE + `
+SELECT id FROM members`
+
+// selectAllMembersQuery selects the subjects given as $1 and, for groups,
+// all of their transitive members.
+const selectAllMembersQuery = `WITH RECURSIVE members (id) AS (
+	SELECT unnest($1::STRING[])
+	UNION
+	SELECT gm.member_id FROM group_members gm
+	JOIN members m ON gm.group_id = m.id
+)
+SELECT id FROM members`
+
+// selectRoleHoldersQuery selects the subjects assigned the role or a role
+// including it, on any scope, along with their transitive members.
+const selectRoleHoldersQuery = `WITH RECURSIVE ` + includersCTE + `, holders (id) AS (
+	SELECT subject_id FROM role_assignments WHERE role_id IN (SELECT id FROM includers)
+	UNION
+	SELECT gm.member_id FROM group_members gm
+	JOIN holders h ON gm.group_id = h.id
+)
+SELECT id FROM holders`
+
+// selectMembershipsScopesQuery selects the scopes of the role assignments
+// and deny rules of the subject and of every group it's a member of.
+const selectMembershipsScopesQuery = `WITH RECURSIVE ` + membershipsCTE + `
+SELECT scope FROM role_assignments WHERE subject_id IN (SELECT id FROM memberships)
+UNION
+SELECT scope FROM deny_rules WHERE subject_id IN (SELECT id FROM memberships)`
+
+const includesMemberQuery = `WITH RECURSIVE ` + membersCTE + `
+SELECT EXISTS (SELECT 1 FROM members WHERE id = $2::STRING)`
+
+const includesRoleQuery = `WITH RECURSIVE ` + includedCTE + `
+SELECT EXISTS (SELECT 1 FROM included WHERE id = $2::UUID)`
+
+const inSubtreeQuery = `WITH RECURSIVE ` + subtreeCTE + `
+SELECT EXISTS (SELECT 1 FROM subtree WHERE id = $2::UUID)`
+
+// grant is a subject being allowed a target on a scope.
+type grant struct {
+	subject string
+	target  string
+	scope   string
+}
+
+// refreshEffectivePermissions recomputes the effective permissions for
+// the directory given as scope and all of its descendants. Permissions
+// are derived from the active role assignments on the directories
+// themselves and on every one of their ancestors, and expire along with
+// the last of these assignments. Roles defined in a directory only
+// apply within its subtree, which matters once a directory is moved out
+// of it. Deny rules on the directories or their ancestors remove whatever
+// they match afterwards. Directories that are deleted, or that have a
+// deleted ancestor, get no effective permissions; their role assignments
+// are kept so they apply again once restored.
+func refreshEffectivePermissions(c context.Context, tx *txn, scope string) error {
+	return refreshSubjectsEffectivePermissions(c, tx, scope, nil)
+}
+
+// refreshSubjectsEffectivePermissions recomputes the effective permissions
+// of the given subjects on the directory given as scope and all of its
+// descendants. A nil list of subjects refreshes every subject.
+func refreshSubjectsEffectivePermissions(c context.Context, tx *txn, scope string, subjects []string) error {
+	rows, err := tx.QueryContext(c, deleteSubtreeEffectivePermissionsQuery, scope, pq.Array(subjects))
+	if err != nil {
+		return fmt.Errorf("couldn't clear effective permissions for %s: %w", scope, err)
+	}
+
+	if err := tx.recordGrants(rows, false); err != nil {
+		return fmt.Errorf("couldn't clear effective permissions for %s: %w", scope, err)
+	}
+
+	rows, err = tx.QueryContext(c, insertSubtreeEffectivePermissionsQuery, scope, pq.Array(subjects))
+	if err != nil {
+		return fmt.Errorf("couldn't compute effective permissions for %s: %w", scope, err)
+	}
+
+	if err := tx.recordGrants(rows, true); err != nil {
+		return fmt.Errorf("couldn't compute effective permissions for %s: %w", scope, err)
+	}
+
+	rows, err = tx.QueryContext(c, deleteDeniedSubtreeEffectivePermissionsQuery, scope, pq.Array(subjects))
+	if err != nil {
+		return fmt.Errorf("couldn't apply deny rules for %s: %w", scope, err)
+	}
+
+	if err := tx.recordGrants(rows, false); err != nil {
+		return fmt.Errorf("couldn't apply deny rules for %s: %w", scope, err)
+	}
+
+	return nil
+}
+
+// refreshMembersEffectivePermissions recomputes the effective permissions
+// of the given subjects and, for groups, of their transitive members on
+// the directory given as scope and all of its descendants. It's called
+// after the role assignments or deny rules of the subjects changed, which
+// don't affect anyone else.
+func refreshMembersEffectivePermissions(c context.Context, tx *txn, scope string, subjects ...string) error {
+	members, err := selectStrings(c, tx, selectAllMembersQuery, pq.Array(subjects))
+	if err != nil {
+		return fmt.Errorf("couldn't get members of %v: %w", subjects, err)
+	}
+
+	return refreshSubjectsEffectivePermissions(c, tx, scope, members)
+}
+
+// refreshTargetEffectivePermissions recomputes the effective permissions
+// for every scope a role granting a wildcard matching one of the given
+// targets is assigned on, so that new targets are granted by the existing
+// wildcards.
+func refreshTargetEffectivePermissions(c context.Context, tx *txn, targets ...string) error {
+	if len(targets) == 0 {
+		return nil
+	}
+
+	wildcards := []string{}
+	for _, target := range targets {
+		wildcards = append(wildcards, apiv1.TargetWildcards(target)...)
+	}
+
+	rows, err := tx.QueryContext(c,
+		"SELECT DISTINCT role_id FROM role_permissions WHERE target = ANY($1)",
+		pq.Array(wildcards))
+	if err != nil {
+		return fmt.Errorf("couldn't get roles granting wildcards: %w", err)
+	}
+
+	roleIDs := []string{}
+
+	for rows.Next() {
+		var roleID string
+		if err := rows.Scan(&roleID); err != nil {
+			rows.Close()
+			return fmt.Errorf("couldn't scan role granting wildcards: %w", err)
+		}
+
+		roleIDs = append(roleIDs, roleID)
+	}
+
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("couldn't get roles granting wildcards: %w", err)
+	}
+
+	for _, roleID := range roleIDs {
+		if err := refreshRoleEffectivePermissions(c, tx, roleID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// refreshRoleEffectivePermissions recomputes the effective permissions
+// for every scope the given role, or any role including it, is assigned on.
+func refreshRoleEffectivePermissions(c context.Context, tx *txn, roleID string) error {
+	scopes, err := roleAssignmentScopes(c, tx, roleID)
+	if err != nil {
+		return err
+	}
+
+	for _, scope := range scopes {
+		if err := refreshEffectivePermissions(c, tx, scope); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// roleAssignmentScopes returns the distinct scopes a role, or any role
+// including it, is assigned on.
+func roleAssignmentScopes(c context.Context, exec boil.ContextExecutor, roleID string) ([]string, error) {
+	rows, err := exec.QueryContext(c, selectIncludersAssignmentScopesQuery, roleID)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get role assignment scopes: %w", err)
+	}
+	defer rows.Close()
+
+	scopes := []string{}
+
+	for rows.Next() {
+		var scope string
+		if err := rows.Scan(&scope); err != nil {
+			return nil, fmt.Errorf("couldn't scan role assignment scope: %w", err)
+		}
+
+		scopes = append(scopes, scope)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("couldn't get role assignment scopes: %w", err)
+	}
+
+	return scopes, nil
+}
+
+// refreshMembershipEffectivePermissions recomputes the effective
+// permissions a subject and its own members get through the given group,
+// on every scope the group or a group containing it has role assignments
+// or deny rules on. It's called after the membership changed.
+func refreshMembershipEffectivePermissions(c context.Context, tx *txn, groupID, subject string) error {
+	scopes, err := selectStrings(c, tx, selectMembershipsScopesQuery, groupID)
+	if err != nil {
+		return fmt.Errorf("couldn't get scopes of group %s: %w", groupID, err)
+	}
+
+	if len(scopes) == 0 {
+		return nil
+	}
+
+	subjects, err := selectStrings(c, tx, selectMembersQuery, subject)
+	if err != nil {
+		return fmt.Errorf("couldn't get members of %s: %w", subject, err)
+	}
+
+	for _, scope := range scopes {
+		if err := refreshSubjectsEffectivePermissions(c, tx, scope, subjects); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// clearEffectivePermissions runs a query removing effective permissions
+// ahead of a cascade, which would remove them without recording it.
+func clearEffectivePermissions(c context.Context, tx *txn, query string, id string) error {
+	rows, err := tx.QueryContext(c, query, id)
+	if err != nil {
+		return fmt.Errorf("couldn't clear effective permissions of %s: %w", id, err)
+	}
+
+	if err := tx.recordGrants(rows, false); err != nil {
+		return fmt.Errorf("couldn't clear effective permissions of %s: %w", id, err)
+	}
+
+	return nil
+}
+
+// includesMember returns whether the subject given as groupID is the
+// subject given as member or contains it, directly or through nested
+// groups.
+func includesMember(c context.Context, exec boil.ContextExecutor, groupID, member string) (bool, error) {
+	var exists bool
+	if err := exec.QueryRowContext(c, includesMemberQuery, groupID, member).Scan(&exists); err != nil {
+		return false, fmt.Errorf("couldn't check members of %s: %w", groupID, err)
+	}
+
+	return exists, nil
+}
+
+// selectStrings runs a query returning a single text column and collects
+// its values.
+func selectStrings(c context.Context, exec boil.ContextExecutor, query string, args ...interface{}) ([]string, error) {
+	rows, err := exec.QueryContext(c, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	values := []string{}
+
+	for rows.Next() {
+		var v string
+		if err := rows.Scan(&v); err != nil {
+			return nil, err
+		}
+
+		values = append(values, v)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+// includesRole returns whether the role given as roleID is the role given
+// as included or includes it, directly or not.
+func includesRole(c context.Context, exec boil.ContextExecutor, roleID, included string) (bool, error) {
+	var exists bool
+	if err := exec.QueryRowContext(c, includesRoleQuery, roleID, included).Scan(&exists); err != nil {
+		return false, fmt.Errorf("couldn't check roles included by %s: %w", roleID, err)
+	}
+
+	return exists, nil
+}
+
+// inSubtree returns whether the directory given as id is the scope itself
+// or one of its descendants.
+func inSubtree(c context.Context, exec boil.ContextExecutor, scope, id string) (bool, error) {
+	var exists bool
+	if err := exec.QueryRowContext(c, inSubtreeQuery, scope, id).Scan(&exists); err != nil {
+		return false, fmt.Errorf("couldn't check subtree of %s: %w", scope, err)
+	}
+
+	return exists, nil
+}
+
+// subtreeGrants returns the effective permissions of the directory given
+// as scope and all of its descendants.
+func subtreeGrants(c context.Context, exec boil.ContextExecutor, scope string) (map[grant]struct{}, error) {
+	rows, err := exec.QueryContext(c, selectSubtreeEffectivePermissionsQuery, scope)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get effective permissions for %s: %w", scope, err)
+	}
+	defer rows.Close()
+
+	grants := map[grant]struct{}{}
+
+	for rows.Next() {
+		var g grant
+		if err := rows.Scan(&g.subject, &g.target, &g.scope); err != nil {
+			return nil, fmt.Errorf("couldn't scan effective permission: %w", err)
+		}
+
+		grants[g] = struct{}{}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("couldn't get effective permissions for %s: %w", scope, err)
+	}
+
+	return grants, nil
+}
+
+// diffGrants summarizes the subjects that gained or lost at least one
+// effective permission between two sets of grants.
+func diffGrants(before, after map[grant]struct{}) *storage.AccessChanges {
+	gained := map[string]struct{}{}
+	lost := map[string]struct{}{}
+
+	for g := range after {
+		if _, ok := before[g]; !ok {
+			gained[g.subject] = struct{}{}
+		}
+	}
+
+	for g := range before {
+		if _, ok := after[g]; !ok {
+			lost[g.subject] = struct{}{}
+		}
+	}
+
+	return &storage.AccessChanges{
+		Gained: sortedKeys(gained),
+		Lost:   sortedKeys(lost),
+	}
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
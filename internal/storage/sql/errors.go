@@ -0,0 +1,41 @@
+package sql
+
+import (
+	"errors"
+
+	"github.com/lib/pq"
+
+	"github.com/infratographer/lmi/internal/storage"
+)
+
+// errorKinds maps the SQLSTATE codes of the database errors caused by
+// requests to the kind of error they are.
+var errorKinds = map[pq.ErrorCode]error{
+	"23505": storage.ErrConflict,         // unique_violation
+	"23503": storage.ErrInvalidReference, // foreign_key_violation
+	"23502": storage.ErrInvalidArgument,  // not_null_violation
+	"23514": storage.ErrInvalidArgument,  // check_violation
+	"22P02": storage.ErrInvalidArgument,  // invalid_text_representation
+	"22007": storage.ErrInvalidArgument,  // invalid_datetime_format
+	"22023": storage.ErrInvalidArgument,  // invalid_parameter_value
+}
+
+// dbError gives database errors caused by requests, such as unique
+// violations or malformed UUIDs, their kind. Other errors are returned
+// as is.
+func dbError(err error) error {
+	if err == nil || storage.Kind(err) != nil {
+		return err
+	}
+
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return err
+	}
+
+	if kind, ok := errorKinds[pqErr.Code]; ok {
+		return storage.WithKind(kind, err)
+	}
+
+	return err
+}
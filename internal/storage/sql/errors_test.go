@@ -0,0 +1,40 @@
+package sql
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/infratographer/lmi/internal/storage"
+)
+
+func TestDBError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		err  error
+		kind error
+	}{
+		{name: "unique violation", err: &pq.Error{Code: "23505"}, kind: storage.ErrConflict},
+		{name: "foreign key violation", err: &pq.Error{Code: "23503"}, kind: storage.ErrInvalidReference},
+		{name: "malformed UUID", err: &pq.Error{Code: "22P02"}, kind: storage.ErrInvalidArgument},
+		{name: "wrapped", err: fmt.Errorf("couldn't create role: %w", &pq.Error{Code: "23505"}), kind: storage.ErrConflict},
+		{name: "other database error", err: &pq.Error{Code: "40001"}},
+		{name: "other error", err: errors.New("boom")},
+		{name: "already kind", err: storage.ErrRoleCycle, kind: storage.ErrInvalidReference},
+	}
+
+	for _, tc := range tests {
+		err := dbError(tc.err)
+
+		assert.ErrorIs(t, err, tc.err, tc.name)
+		assert.Equal(t, tc.kind, storage.Kind(err), tc.name)
+		assert.Equal(t, tc.err.Error(), err.Error(), tc.name)
+	}
+
+	assert.NoError(t, dbError(nil))
+}
@@ -0,0 +1,410 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/cockroachdb/cockroach-go/v2/crdb"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+	"github.com/infratographer/lmi/internal/storage"
+	"github.com/infratographer/lmi/internal/storage/sql/models"
+)
+
+// txn is a transaction that records the events describing the changes
+// made in it. They're written to the outbox before it's committed, so
+// they're relayed if and only if the changes are. The changes are also
+// audited on behalf of the actor of the transaction's context.
+type txn struct {
+	*sql.Tx
+
+	actor   string
+	events  []*apiv1.Event
+	audited []*auditEntry
+	grants  map[grant]*grantChange
+}
+
+// grantChange tracks an effective permission across the recomputations
+// done in a transaction: whether it existed before the transaction and
+// whether it exists now, along with the role granting it.
+type grantChange struct {
+	before bool
+	after  bool
+	role   string
+}
+
+// executeTx runs fn in a transaction, retrying it as needed, and writes
+// the events it recorded to the outbox and the audit log before
+// committing. Database errors are given their kind once retries are over,
+// so that retryable ones are still recognized.
+func (drv *sqlDriver) executeTx(c context.Context, fn func(tx *txn) error) error {
+	err := crdb.ExecuteTx(c, drv.db, nil, func(sqlTx *sql.Tx) error {
+		// a retried attempt starts over with no events
+		tx := &txn{
+			Tx:     sqlTx,
+			actor:  storage.ActorFromContext(c),
+			grants: map[grant]*grantChange{},
+		}
+
+		if err := fn(tx); err != nil {
+			return err
+		}
+
+		if err := tx.writeAudit(c); err != nil {
+			return err
+		}
+
+		return tx.writeOutbox(c)
+	})
+
+	return dbError(err)
+}
+
+// savepoint runs fn in a savepoint. If fn fails because of the request,
+// its changes and the events it recorded are rolled back, and the error
+// is returned with its kind, leaving the transaction usable. Other errors
+// are returned as is, since they fail the whole transaction.
+func (tx *txn) savepoint(c context.Context, fn func() error) error {
+	if _, err := tx.ExecContext(c, "SAVEPOINT change"); err != nil {
+		return fmt.Errorf("couldn't create savepoint: %w", err)
+	}
+
+	events, audited := len(tx.events), len(tx.audited)
+
+	if err := fn(); err != nil {
+		kindErr := dbError(err)
+		if storage.Kind(kindErr) == nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(c, "ROLLBACK TO SAVEPOINT change"); err != nil {
+			return fmt.Errorf("couldn't roll back to savepoint: %w", err)
+		}
+
+		tx.events, tx.audited = tx.events[:events], tx.audited[:audited]
+
+		return kindErr
+	}
+
+	if _, err := tx.ExecContext(c, "RELEASE SAVEPOINT change"); err != nil {
+		return fmt.Errorf("couldn't release savepoint: %w", err)
+	}
+
+	return nil
+}
+
+// record adds an event of the given type, letting set fill in what the
+// event is about, and audits the change it describes.
+func (tx *txn) record(t apiv1.EventType, set func(evt *apiv1.Event)) {
+	evt := apiv1.NewEvent(t)
+	set(evt)
+
+	tx.events = append(tx.events, evt)
+	tx.auditEvent(evt, nil)
+}
+
+// recordUpdate is like record for updates, also letting before fill in
+// what the event is about as it was before the update, for the audit log.
+func (tx *txn) recordUpdate(t apiv1.EventType, before, set func(evt *apiv1.Event)) {
+	prev := &apiv1.Event{}
+	before(prev)
+
+	evt := apiv1.NewEvent(t)
+	set(evt)
+
+	tx.events = append(tx.events, evt)
+	tx.auditEvent(evt, &prev.EventDetails)
+}
+
+// recordGrants records the effective permissions returned by rows, as
+// subject, target, scope and role, as existing or not after the change.
+func (tx *txn) recordGrants(rows *sql.Rows, exists bool) error {
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			g    grant
+			role string
+		)
+
+		if err := rows.Scan(&g.subject, &g.target, &g.scope, &role); err != nil {
+			return fmt.Errorf("couldn't scan effective permission: %w", err)
+		}
+
+		gc, ok := tx.grants[g]
+		if !ok {
+			// the first time we see a permission, it existed
+			// before the transaction if it's being removed.
+			gc = &grantChange{before: !exists}
+			tx.grants[g] = gc
+		}
+
+		gc.after = exists
+		gc.role = role
+	}
+
+	return rows.Err()
+}
+
+// writeOutbox writes the recorded events to the outbox, in order.
+func (tx *txn) writeOutbox(c context.Context) error {
+	for _, evt := range tx.flush() {
+		payload, err := json.Marshal(evt)
+		if err != nil {
+			return fmt.Errorf("couldn't encode %s event: %w", evt.Type, err)
+		}
+
+		outbox := &models.EventOutbox{
+			EventType: string(evt.Type),
+			Payload:   payload,
+		}
+
+		if err := outbox.Insert(c, tx, boil.Infer()); err != nil {
+			return fmt.Errorf("couldn't write %s event to the outbox: %w", evt.Type, err)
+		}
+	}
+
+	return nil
+}
+
+// flush returns the recorded events, followed by the effective
+// permission changes of every subject.
+func (tx *txn) flush() []*apiv1.Event {
+	bySubject := map[string]*apiv1.EffectivePermissionChanges{}
+
+	for g, gc := range tx.grants {
+		if gc.before == gc.after {
+			continue
+		}
+
+		changes, ok := bySubject[g.subject]
+		if !ok {
+			changes = &apiv1.EffectivePermissionChanges{
+				Subject: g.subject,
+				Granted: []apiv1.EffectivePermission{},
+				Revoked: []apiv1.EffectivePermission{},
+			}
+			bySubject[g.subject] = changes
+		}
+
+		ep := apiv1.EffectivePermission{
+			Target: g.target,
+			Scope:  g.scope,
+			Role:   gc.role,
+		}
+
+		if gc.after {
+			changes.Granted = append(changes.Granted, ep)
+		} else {
+			changes.Revoked = append(changes.Revoked, ep)
+		}
+	}
+
+	subjects := make([]string, 0, len(bySubject))
+	for subject := range bySubject {
+		subjects = append(subjects, subject)
+	}
+
+	sort.Strings(subjects)
+
+	evts := tx.events
+
+	for _, subject := range subjects {
+		changes := bySubject[subject]
+		sortEffectivePermissions(changes.Granted)
+		sortEffectivePermissions(changes.Revoked)
+
+		evt := apiv1.NewEvent(apiv1.EventEffectivePermissionsChanged)
+		evt.Changes = changes
+
+		evts = append(evts, evt)
+	}
+
+	return evts
+}
+
+func sortEffectivePermissions(eps []apiv1.EffectivePermission) {
+	sort.Slice(eps, func(i, j int) bool {
+		if eps[i].Scope != eps[j].Scope {
+			return eps[i].Scope < eps[j].Scope
+		}
+
+		return eps[i].Target < eps[j].Target
+	})
+}
+
+// roleInfo converts a role to what's exposed by the API, without its
+// permissions.
+func roleInfo(r *models.Role) (*apiv1.RoleInfo, error) {
+	roleID, err := apiv1.ParseEntityID(r.ID)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't parse role ID %s: %w", r.ID, err)
+	}
+
+	// the role may be updated later on in the transaction
+	description := r.Description
+
+	return &apiv1.RoleInfo{
+		Id:          roleID,
+		Name:        r.Name,
+		Description: &description,
+		Directory:   r.DirectoryID.Ptr(),
+		Version:     &r.Version,
+		CreatedAt:   r.CreatedAt,
+		UpdatedAt:   r.UpdatedAt,
+	}, nil
+}
+
+// recordRole records an event about a role.
+func recordRole(tx *txn, t apiv1.EventType, r *models.Role) error {
+	info, err := roleInfo(r)
+	if err != nil {
+		return err
+	}
+
+	tx.record(t, func(evt *apiv1.Event) {
+		evt.Role = info
+	})
+
+	return nil
+}
+
+// recordRoleUpdate records an event about an updated role, given the
+// role as it was before the update.
+func recordRoleUpdate(tx *txn, before *apiv1.RoleInfo, r *models.Role) error {
+	info, err := roleInfo(r)
+	if err != nil {
+		return err
+	}
+
+	tx.recordUpdate(apiv1.EventRoleUpdated,
+		func(evt *apiv1.Event) {
+			evt.Role = before
+		},
+		func(evt *apiv1.Event) {
+			evt.Role = info
+		})
+
+	return nil
+}
+
+// recordRolePermission records an event about a permission added to or
+// removed from a role.
+func recordRolePermission(tx *txn, t apiv1.EventType, r *models.Role, p *models.Permission) error {
+	info, err := roleInfo(r)
+	if err != nil {
+		return err
+	}
+
+	tx.record(t, func(evt *apiv1.Event) {
+		evt.Role = info
+		evt.Permission = permission(p)
+	})
+
+	return nil
+}
+
+// recordAssignment records an event about a role assignment.
+func recordAssignment(tx *txn, t apiv1.EventType, ra *models.RoleAssignment) error {
+	a, err := roleAssignment(ra)
+	if err != nil {
+		return err
+	}
+
+	tx.record(t, func(evt *apiv1.Event) {
+		evt.Assignment = a
+	})
+
+	return nil
+}
+
+// recordAssignmentUpdate records an event about an updated role
+// assignment, given the assignment as it was before the update.
+func recordAssignmentUpdate(tx *txn, before *apiv1.Assignment, ra *models.RoleAssignment) error {
+	a, err := roleAssignment(ra)
+	if err != nil {
+		return err
+	}
+
+	tx.recordUpdate(apiv1.EventAssignmentUpdated,
+		func(evt *apiv1.Event) {
+			evt.Assignment = before
+		},
+		func(evt *apiv1.Event) {
+			evt.Assignment = a
+		})
+
+	return nil
+}
+
+func roleAssignment(ra *models.RoleAssignment) (*apiv1.Assignment, error) {
+	roleID, err := apiv1.ParseEntityID(ra.RoleID)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't parse role ID for role assignment %s", ra.ID)
+	}
+
+	return &apiv1.Assignment{
+		Role:      roleID,
+		Subject:   ra.SubjectID,
+		Scope:     ra.Scope,
+		NotBefore: ra.NotBefore.Ptr(),
+		ExpiresAt: ra.ExpiresAt.Ptr(),
+	}, nil
+}
+
+// recordPermissionUpdate records an event about an updated permission,
+// given the permission as it was before the update.
+func recordPermissionUpdate(tx *txn, before *apiv1.Permission, p *models.Permission) {
+	tx.recordUpdate(apiv1.EventPermissionUpdated,
+		func(evt *apiv1.Event) {
+			evt.Permission = before
+		},
+		func(evt *apiv1.Event) {
+			evt.Permission = permission(p)
+		})
+}
+
+func permission(p *models.Permission) *apiv1.Permission {
+	description := p.Description
+
+	return &apiv1.Permission{
+		Target:      p.Target,
+		Description: &description,
+	}
+}
+
+// recordRoleInclude records an event about a role included in or removed
+// from another role.
+func recordRoleInclude(tx *txn, t apiv1.EventType, r *models.Role, included apiv1.RoleIdentifier) error {
+	info, err := roleInfo(r)
+	if err != nil {
+		return err
+	}
+
+	tx.record(t, func(evt *apiv1.Event) {
+		evt.Role = info
+		evt.IncludedRole = &included
+	})
+
+	return nil
+}
+
+// recordSubject records an event about a tracked subject.
+func recordSubject(tx *txn, t apiv1.EventType, id string) {
+	tx.record(t, func(evt *apiv1.Event) {
+		evt.Subject = &apiv1.Subject{Id: id}
+	})
+}
+
+// recordGroupMember records an event about a member added to or removed
+// from a group.
+func recordGroupMember(tx *txn, t apiv1.EventType, g *apiv1.Group, member apiv1.Subject) {
+	tx.record(t, func(evt *apiv1.Event) {
+		evt.Group = g
+		evt.Member = &member
+	})
+}
@@ -0,0 +1,117 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+	"github.com/infratographer/lmi/internal/storage"
+)
+
+func (drv *sqlDriver) SweepRoleAssignments(c context.Context, since time.Time) ([]storage.LapsedPermission, error) {
+	var lapsed []storage.LapsedPermission
+
+	err := drv.executeTx(c, func(tx *txn) error {
+		lapsed = []storage.LapsedPermission{}
+
+		rows, err := tx.QueryContext(c, deleteLapsedEffectivePermissionsQuery)
+		if err != nil {
+			return fmt.Errorf("couldn't remove lapsed effective permissions: %w", err)
+		}
+
+		for rows.Next() {
+			var lp storage.LapsedPermission
+			if err := rows.Scan(&lp.Subject, &lp.Target, &lp.Scope, &lp.Role); err != nil {
+				rows.Close()
+				return fmt.Errorf("couldn't scan lapsed effective permission: %w", err)
+			}
+
+			lapsed = append(lapsed, lp)
+		}
+
+		rows.Close()
+
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("couldn't remove lapsed effective permissions: %w", err)
+		}
+
+		recordLapsed(tx, lapsed)
+
+		scopes, err := activatedAssignmentScopes(c, tx, since)
+		if err != nil {
+			return err
+		}
+
+		for _, scope := range scopes {
+			if err := refreshEffectivePermissions(c, tx, scope); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return lapsed, nil
+}
+
+// activatedAssignmentScopes returns the distinct scopes of the role
+// assignments that became active after the given time.
+func activatedAssignmentScopes(c context.Context, tx *txn, since time.Time) ([]string, error) {
+	rows, err := tx.QueryContext(c, selectActivatedAssignmentScopesQuery, since)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get activated role assignment scopes: %w", err)
+	}
+	defer rows.Close()
+
+	scopes := []string{}
+
+	for rows.Next() {
+		var scope string
+		if err := rows.Scan(&scope); err != nil {
+			return nil, fmt.Errorf("couldn't scan role assignment scope: %w", err)
+		}
+
+		scopes = append(scopes, scope)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("couldn't get activated role assignment scopes: %w", err)
+	}
+
+	return scopes, nil
+}
+
+// recordLapsed records an event for every subject with lapsed effective
+// permissions.
+func recordLapsed(tx *txn, lapsed []storage.LapsedPermission) {
+	subjects := []string{}
+	bySubject := map[string][]apiv1.EffectivePermission{}
+
+	for _, lp := range lapsed {
+		if _, ok := bySubject[lp.Subject]; !ok {
+			subjects = append(subjects, lp.Subject)
+		}
+
+		bySubject[lp.Subject] = append(bySubject[lp.Subject], apiv1.EffectivePermission{
+			Target: lp.Target,
+			Scope:  lp.Scope,
+			Role:   lp.Role,
+		})
+	}
+
+	for _, subject := range subjects {
+		changes := &apiv1.EffectivePermissionChanges{
+			Subject: subject,
+			Granted: []apiv1.EffectivePermission{},
+			Revoked: bySubject[subject],
+		}
+
+		tx.record(apiv1.EventEffectivePermissionsLapsed, func(evt *apiv1.Event) {
+			evt.Changes = changes
+		})
+	}
+}
@@ -0,0 +1,348 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+	"github.com/infratographer/lmi/internal/storage"
+	"github.com/infratographer/lmi/internal/storage/sql/models"
+)
+
+// groupIDPrefix is the prefix of the subject IDs given to new groups.
+const groupIDPrefix = "urn:infratographer:group:"
+
+func (drv *sqlDriver) GetGroups(c context.Context) ([]*apiv1.Group, error) {
+	sgs, err := models.SubjectGroups(
+		qm.OrderBy(models.SubjectGroupColumns.Name),
+	).All(c, drv.db)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get groups: %w", err)
+	}
+
+	groups := make([]*apiv1.Group, len(sgs))
+	for i, sg := range sgs {
+		groups[i] = group(sg)
+	}
+
+	return groups, nil
+}
+
+func (drv *sqlDriver) CreateGroup(c context.Context, newGroup apiv1.NewGroup) (*apiv1.Group, error) {
+	var out *apiv1.Group
+
+	err := drv.executeTx(c, func(tx *txn) error {
+		if err := checkGroupName(c, tx, "", newGroup.Name); err != nil {
+			return err
+		}
+
+		s := &models.TrackedSubject{
+			SubjectID: groupIDPrefix + uuid.NewString(),
+		}
+
+		if err := s.Insert(c, tx, boil.Infer()); err != nil {
+			return fmt.Errorf("couldn't track group subject: %w", err)
+		}
+
+		sg := &models.SubjectGroup{
+			SubjectID:   s.SubjectID,
+			Name:        newGroup.Name,
+			Description: description(newGroup.Description),
+		}
+
+		if err := sg.Insert(c, tx, boil.Infer()); err != nil {
+			return fmt.Errorf("couldn't create group: %w", err)
+		}
+
+		g := group(sg)
+
+		tx.record(apiv1.EventGroupCreated, func(evt *apiv1.Event) {
+			evt.Group = g
+		})
+
+		out = g
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (drv *sqlDriver) GetGroup(c context.Context, id string) (*apiv1.Group, error) {
+	sg, err := findGroup(c, drv.db, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return group(sg), nil
+}
+
+func (drv *sqlDriver) UpdateGroup(c context.Context, id string, update apiv1.NewGroup) (*apiv1.Group, error) {
+	var out *apiv1.Group
+
+	err := drv.executeTx(c, func(tx *txn) error {
+		sg, err := findGroup(c, tx, id)
+		if err != nil {
+			return err
+		}
+
+		before := group(sg)
+
+		if err := checkGroupName(c, tx, id, update.Name); err != nil {
+			return err
+		}
+
+		sg.Name = update.Name
+		sg.Description = description(update.Description)
+
+		if _, err := sg.Update(c, tx, boil.Infer()); err != nil {
+			return fmt.Errorf("couldn't update group: %w", err)
+		}
+
+		g := group(sg)
+
+		tx.recordUpdate(apiv1.EventGroupUpdated,
+			func(evt *apiv1.Event) {
+				evt.Group = before
+			},
+			func(evt *apiv1.Event) {
+				evt.Group = g
+			})
+
+		out = g
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (drv *sqlDriver) DeleteGroup(c context.Context, id string) error {
+	return drv.executeTx(c, func(tx *txn) error {
+		sg, err := findGroup(c, tx, id)
+		if err != nil {
+			return err
+		}
+
+		g := group(sg)
+
+		impact, err := groupImpactOf(c, tx, id)
+		if err != nil {
+			return err
+		}
+
+		// The cascade would remove the group's effective permissions
+		// too, but without recording the change.
+		if err := clearEffectivePermissions(c, tx, deleteSubjectEffectivePermissionsQuery, id); err != nil {
+			return err
+		}
+
+		// the group's memberships and role assignments are removed by the cascade.
+		if _, err := models.TrackedSubjects(models.TrackedSubjectWhere.SubjectID.EQ(id)).DeleteAll(c, tx); err != nil {
+			return fmt.Errorf("couldn't delete group: %w", err)
+		}
+
+		tx.record(apiv1.EventGroupDeleted, func(evt *apiv1.Event) {
+			evt.Group = g
+		})
+
+		return impact.refresh(c, tx)
+	})
+}
+
+func (drv *sqlDriver) GetGroupMembers(c context.Context, id string) ([]*apiv1.Subject, error) {
+	sg, err := findGroup(c, drv.db, id)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := sg.GroupGroupMembers(
+		qm.OrderBy(models.GroupMemberColumns.MemberID),
+	).All(c, drv.db)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get members of group %s: %w", id, err)
+	}
+
+	subjects := make([]*apiv1.Subject, len(members))
+	for i, m := range members {
+		subjects[i] = &apiv1.Subject{
+			Id: m.MemberID,
+		}
+	}
+
+	return subjects, nil
+}
+
+func (drv *sqlDriver) AddGroupMember(c context.Context, id string, member apiv1.Subject) error {
+	return drv.executeTx(c, func(tx *txn) error {
+		sg, err := findGroup(c, tx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := drv.ensureSubject(c, tx, member.Id); err != nil {
+			return err
+		}
+
+		cyclic, err := includesMember(c, tx, member.Id, id)
+		if err != nil {
+			return err
+		}
+
+		if cyclic {
+			return fmt.Errorf("%s contains group %s: %w", member.Id, id, storage.ErrGroupCycle)
+		}
+
+		exists, err := models.GroupMemberExists(c, tx, id, member.Id)
+		if err != nil {
+			return fmt.Errorf("couldn't check if subject is a member: %w", err)
+		}
+
+		// already a member
+		if exists {
+			return nil
+		}
+
+		gm := &models.GroupMember{
+			GroupID:  id,
+			MemberID: member.Id,
+		}
+
+		if err := gm.Insert(c, tx, boil.Infer()); err != nil {
+			return fmt.Errorf("couldn't add group member: %w", err)
+		}
+
+		recordGroupMember(tx, apiv1.EventGroupMemberAdded, group(sg), member)
+
+		return refreshMembershipEffectivePermissions(c, tx, id, member.Id)
+	})
+}
+
+func (drv *sqlDriver) RemoveGroupMember(c context.Context, id string, member apiv1.Subject) error {
+	return drv.executeTx(c, func(tx *txn) error {
+		sg, err := findGroup(c, tx, id)
+		if err != nil {
+			return err
+		}
+
+		gm, err := models.FindGroupMember(c, tx, id, member.Id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return storage.ErrNotFound
+			}
+			return fmt.Errorf("couldn't find group member: %w", err)
+		}
+
+		if _, err := gm.Delete(c, tx); err != nil {
+			return fmt.Errorf("couldn't remove group member: %w", err)
+		}
+
+		recordGroupMember(tx, apiv1.EventGroupMemberRemoved, group(sg), member)
+
+		return refreshMembershipEffectivePermissions(c, tx, id, member.Id)
+	})
+}
+
+// groupImpact is what has to be refreshed once a group is gone: the
+// scopes it has role assignments or deny rules on, directly or through
+// the groups containing it, and its transitive members.
+type groupImpact struct {
+	scopes   []string
+	subjects []string
+}
+
+// groupImpactOf collects the impact of a group before it's deleted. It's
+// empty for subjects that aren't groups.
+func groupImpactOf(c context.Context, exec boil.ContextExecutor, id string) (*groupImpact, error) {
+	subjects, err := selectStrings(c, exec, selectMembersQuery, id)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get members of %s: %w", id, err)
+	}
+
+	if len(subjects) == 1 {
+		return &groupImpact{}, nil
+	}
+
+	scopes, err := selectStrings(c, exec, selectMembershipsScopesQuery, id)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get scopes of group %s: %w", id, err)
+	}
+
+	return &groupImpact{
+		scopes:   scopes,
+		subjects: subjects,
+	}, nil
+}
+
+func (i *groupImpact) refresh(c context.Context, tx *txn) error {
+	for _, scope := range i.scopes {
+		if err := refreshSubjectsEffectivePermissions(c, tx, scope, i.subjects); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkGroupName verifies no group other than the one given as id is
+// already named name.
+func checkGroupName(c context.Context, exec boil.ContextExecutor, id, name string) error {
+	exists, err := models.SubjectGroups(
+		models.SubjectGroupWhere.Name.EQ(name),
+		models.SubjectGroupWhere.SubjectID.NEQ(id),
+	).Exists(c, exec)
+	if err != nil {
+		return fmt.Errorf("couldn't check group name: %w", err)
+	}
+
+	if exists {
+		return fmt.Errorf("group %s: %w", name, storage.ErrAlreadyExists)
+	}
+
+	return nil
+}
+
+func findGroup(c context.Context, exec boil.ContextExecutor, id string) (*models.SubjectGroup, error) {
+	sg, err := models.FindSubjectGroup(c, exec, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, fmt.Errorf("couldn't find group: %w", err)
+	}
+
+	return sg, nil
+}
+
+func description(d *string) string {
+	if d == nil {
+		return ""
+	}
+
+	return *d
+}
+
+// group converts a group model.
+func group(sg *models.SubjectGroup) *apiv1.Group {
+	desc := sg.Description
+
+	return &apiv1.Group{
+		Id:          sg.SubjectID,
+		Name:        sg.Name,
+		Description: &desc,
+		CreatedAt:   sg.CreatedAt,
+		UpdatedAt:   sg.UpdatedAt,
+	}
+}
@@ -0,0 +1,199 @@
+package sql_test
+
+import (
+	"context"
+	"testing"
+
+	fsv1 "github.com/infratographer/fertilesoil/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+	"github.com/infratographer/lmi/internal/storage"
+	sqlstore "github.com/infratographer/lmi/internal/storage/sql"
+)
+
+func TestGroups(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	env := newTestEnv(t)
+
+	description := "the ops team"
+
+	ops, err := env.store.CreateGroup(ctx, apiv1.NewGroup{Name: "ops", Description: &description})
+	require.NoError(t, err)
+
+	t.Run("groups are subjects", func(t *testing.T) {
+		subject, err := env.store.GetSubject(ctx, ops.Id)
+		require.NoError(t, err)
+		assert.Equal(t, ops.Id, subject.Id)
+	})
+
+	t.Run("groups can be read back", func(t *testing.T) {
+		g, err := env.store.GetGroup(ctx, ops.Id)
+		require.NoError(t, err)
+		assert.Equal(t, "ops", g.Name)
+		assert.Equal(t, &description, g.Description)
+
+		groups, err := env.store.GetGroups(ctx)
+		require.NoError(t, err)
+		require.Len(t, groups, 1)
+		assert.Equal(t, ops.Id, groups[0].Id)
+	})
+
+	t.Run("group names are unique", func(t *testing.T) {
+		_, err := env.store.CreateGroup(ctx, apiv1.NewGroup{Name: "ops"})
+		assert.ErrorIs(t, err, storage.ErrAlreadyExists)
+
+		dev, err := env.store.CreateGroup(ctx, apiv1.NewGroup{Name: "dev"})
+		require.NoError(t, err)
+
+		_, err = env.store.UpdateGroup(ctx, dev.Id, apiv1.NewGroup{Name: "ops"})
+		assert.ErrorIs(t, err, storage.ErrAlreadyExists)
+
+		require.NoError(t, env.store.DeleteGroup(ctx, dev.Id))
+	})
+
+	t.Run("groups can be renamed", func(t *testing.T) {
+		g, err := env.store.UpdateGroup(ctx, ops.Id, apiv1.NewGroup{Name: "sre"})
+		require.NoError(t, err)
+		assert.Equal(t, "sre", g.Name)
+		assert.Empty(t, *g.Description)
+
+		g, err = env.store.GetGroup(ctx, ops.Id)
+		require.NoError(t, err)
+		assert.Equal(t, "sre", g.Name)
+	})
+
+	t.Run("members can be added and removed", func(t *testing.T) {
+		member := env.trackSubject(ctx, t)
+
+		require.NoError(t, env.store.AddGroupMember(ctx, ops.Id, apiv1.Subject{Id: member}))
+		require.NoError(t, env.store.AddGroupMember(ctx, ops.Id, apiv1.Subject{Id: member}), "already a member")
+
+		members, err := env.store.GetGroupMembers(ctx, ops.Id)
+		require.NoError(t, err)
+		assert.Equal(t, []*apiv1.Subject{{Id: member}}, members)
+
+		require.NoError(t, env.store.RemoveGroupMember(ctx, ops.Id, apiv1.Subject{Id: member}))
+
+		err = env.store.RemoveGroupMember(ctx, ops.Id, apiv1.Subject{Id: member})
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+
+		members, err = env.store.GetGroupMembers(ctx, ops.Id)
+		require.NoError(t, err)
+		assert.Empty(t, members)
+	})
+
+	t.Run("untracked members are rejected", func(t *testing.T) {
+		err := env.store.AddGroupMember(ctx, ops.Id, apiv1.Subject{Id: newSubject()})
+		assert.ErrorIs(t, err, storage.ErrSubjectNotTracked)
+	})
+
+	t.Run("deleted groups are gone", func(t *testing.T) {
+		g, err := env.store.CreateGroup(ctx, apiv1.NewGroup{Name: "temp"})
+		require.NoError(t, err)
+
+		require.NoError(t, env.store.DeleteGroup(ctx, g.Id))
+
+		_, err = env.store.GetGroup(ctx, g.Id)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+
+		_, err = env.store.GetSubject(ctx, g.Id)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
+
+func TestNestedGroups(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	env := newTestEnv(t, sqlstore.WithAutoTrackSubjects(true))
+
+	root := env.addDirectory(ctx, t, nil)
+	child := env.addDirectory(ctx, t, &root)
+
+	newGroup := func(t *testing.T, name string) string {
+		t.Helper()
+
+		g, err := env.store.CreateGroup(ctx, apiv1.NewGroup{Name: name})
+		require.NoError(t, err)
+
+		return g.Id
+	}
+
+	addMember := func(t *testing.T, group, member string) {
+		t.Helper()
+
+		require.NoError(t, env.store.AddGroupMember(ctx, group, apiv1.Subject{Id: member}))
+	}
+
+	engineering := newGroup(t, "engineering")
+	platform := newGroup(t, "platform")
+	oncall := newGroup(t, "oncall")
+
+	addMember(t, engineering, platform)
+	addMember(t, platform, oncall)
+
+	user := newSubject()
+	addMember(t, oncall, user)
+
+	roleID := env.createRole(ctx, t, apiv1.NewRole{Name: "instance-creator"}, testTarget)
+	env.assignRole(ctx, t, roleID, engineering, root)
+
+	t.Run("cycles are rejected", func(t *testing.T) {
+		err := env.store.AddGroupMember(ctx, oncall, apiv1.Subject{Id: engineering})
+		assert.ErrorIs(t, err, storage.ErrGroupCycle)
+
+		err = env.store.AddGroupMember(ctx, platform, apiv1.Subject{Id: platform})
+		assert.ErrorIs(t, err, storage.ErrGroupCycle)
+	})
+
+	t.Run("grants reach members through nested groups", func(t *testing.T) {
+		for _, scope := range []fsv1.DirectoryID{root, child} {
+			for _, subject := range []string{engineering, platform, oncall, user} {
+				res := env.check(ctx, t, subject, testTarget, scope)
+				assert.True(t, res.Allowed, subject)
+				assert.Equal(t, &roleID, res.Role, subject)
+			}
+		}
+	})
+
+	t.Run("membership changes only refresh the member's subtree", func(t *testing.T) {
+		other := newSubject()
+		env.assignRole(ctx, t, roleID, other, root)
+
+		seen := len(env.events(ctx, t))
+
+		require.NoError(t, env.store.RemoveGroupMember(ctx, engineering, apiv1.Subject{Id: platform}))
+
+		evts := env.events(ctx, t)[seen:]
+		assert.ElementsMatch(t, []string{platform, oncall, user}, changedSubjects(evts))
+
+		for _, subject := range []string{platform, oncall, user} {
+			assert.False(t, env.check(ctx, t, subject, testTarget, child).Allowed, subject)
+		}
+
+		assert.True(t, env.check(ctx, t, engineering, testTarget, child).Allowed)
+		assert.True(t, env.check(ctx, t, other, testTarget, child).Allowed)
+
+		seen = len(env.events(ctx, t))
+
+		addMember(t, engineering, oncall)
+
+		evts = env.events(ctx, t)[seen:]
+		assert.ElementsMatch(t, []string{oncall, user}, changedSubjects(evts))
+
+		assert.True(t, env.check(ctx, t, user, testTarget, child).Allowed)
+		assert.False(t, env.check(ctx, t, platform, testTarget, child).Allowed)
+	})
+
+	t.Run("deleting a group revokes what its members got through it", func(t *testing.T) {
+		require.NoError(t, env.store.DeleteGroup(ctx, engineering))
+
+		for _, subject := range []string{oncall, user} {
+			assert.False(t, env.check(ctx, t, subject, testTarget, root).Allowed, subject)
+		}
+	})
+}
@@ -0,0 +1,143 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/cockroach-go/v2/crdb"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/infratographer/lmi/internal/storage"
+	"github.com/infratographer/lmi/internal/storage/sql/models"
+)
+
+func (drv *sqlDriver) ReserveIdempotencyKey(
+	c context.Context,
+	key storage.IdempotencyKey,
+	fingerprint string,
+	expiresAt time.Time,
+) (*storage.IdempotentRequest, error) {
+	var req *storage.IdempotentRequest
+
+	err := crdb.ExecuteTx(c, drv.db, nil, func(tx *sql.Tx) error {
+		var err error
+
+		req, err = idempotentRequest(c, tx, key)
+		if err != nil || req != nil {
+			return err
+		}
+
+		// an expired key is reserved again
+		reserved := &models.IdempotencyKey{
+			Actor:       key.Actor,
+			Key:         key.Key,
+			Fingerprint: fingerprint,
+			ExpiresAt:   expiresAt,
+		}
+
+		if err := reserved.Upsert(c, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+			return fmt.Errorf("couldn't reserve idempotency key: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	return req, nil
+}
+
+// idempotentRequest gets the request made with a key that hasn't expired,
+// locking it until it's reserved again or left as is.
+func idempotentRequest(c context.Context, tx *sql.Tx, key storage.IdempotencyKey) (*storage.IdempotentRequest, error) {
+	k, err := models.IdempotencyKeys(
+		models.IdempotencyKeyWhere.Actor.EQ(key.Actor),
+		models.IdempotencyKeyWhere.Key.EQ(key.Key),
+		models.IdempotencyKeyWhere.ExpiresAt.GT(time.Now()),
+		qm.For("UPDATE"),
+	).One(c, tx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("couldn't get idempotency key: %w", err)
+	}
+
+	req := &storage.IdempotentRequest{
+		Fingerprint: k.Fingerprint,
+	}
+
+	if !k.Status.Valid {
+		return req, nil
+	}
+
+	req.Response = &storage.IdempotentResponse{
+		Status: int(k.Status.Int64),
+		Body:   k.Body.Bytes,
+	}
+
+	if err := k.Header.Unmarshal(&req.Response.Header); err != nil {
+		return nil, fmt.Errorf("couldn't decode idempotent response header: %w", err)
+	}
+
+	return req, nil
+}
+
+func (drv *sqlDriver) SaveIdempotentResponse(
+	c context.Context,
+	key storage.IdempotencyKey,
+	resp storage.IdempotentResponse,
+) error {
+	header, err := json.Marshal(resp.Header)
+	if err != nil {
+		return fmt.Errorf("couldn't encode idempotent response header: %w", err)
+	}
+
+	rows, err := models.IdempotencyKeys(
+		models.IdempotencyKeyWhere.Actor.EQ(key.Actor),
+		models.IdempotencyKeyWhere.Key.EQ(key.Key),
+	).UpdateAll(c, drv.db, models.M{
+		models.IdempotencyKeyColumns.Status: resp.Status,
+		models.IdempotencyKeyColumns.Header: header,
+		models.IdempotencyKeyColumns.Body:   resp.Body,
+	})
+	if err != nil {
+		return dbError(fmt.Errorf("couldn't save idempotent response: %w", err))
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("idempotency key %s: %w", key.Key, storage.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (drv *sqlDriver) ReleaseIdempotencyKey(c context.Context, key storage.IdempotencyKey) error {
+	_, err := models.IdempotencyKeys(
+		models.IdempotencyKeyWhere.Actor.EQ(key.Actor),
+		models.IdempotencyKeyWhere.Key.EQ(key.Key),
+		models.IdempotencyKeyWhere.Status.IsNull(),
+	).DeleteAll(c, drv.db)
+	if err != nil {
+		return fmt.Errorf("couldn't release idempotency key: %w", err)
+	}
+
+	return nil
+}
+
+func (drv *sqlDriver) PruneIdempotencyKeys(c context.Context, before time.Time) (int64, error) {
+	rows, err := models.IdempotencyKeys(
+		models.IdempotencyKeyWhere.ExpiresAt.LT(before),
+	).DeleteAll(c, drv.db)
+	if err != nil {
+		return 0, fmt.Errorf("couldn't prune idempotency keys: %w", err)
+	}
+
+	return rows, nil
+}
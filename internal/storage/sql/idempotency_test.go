@@ -0,0 +1,105 @@
+package sql_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/infratographer/lmi/internal/storage"
+)
+
+func TestIdempotencyKeys(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	env := newTestEnv(t)
+
+	alice, bob := newSubject(), newSubject()
+	key := storage.IdempotencyKey{Actor: alice, Key: "create-viewer"}
+	expiresAt := time.Now().Add(time.Hour)
+
+	t.Run("keys are reserved once", func(t *testing.T) {
+		prev, err := env.store.ReserveIdempotencyKey(ctx, key, "viewer", expiresAt)
+		require.NoError(t, err)
+		assert.Nil(t, prev)
+
+		prev, err = env.store.ReserveIdempotencyKey(ctx, key, "viewer", expiresAt)
+		require.NoError(t, err)
+		assert.Equal(t, &storage.IdempotentRequest{Fingerprint: "viewer"}, prev, "in progress")
+
+		prev, err = env.store.ReserveIdempotencyKey(ctx, key, "editor", expiresAt)
+		require.NoError(t, err)
+		assert.Equal(t, "viewer", prev.Fingerprint, "the original request is kept")
+	})
+
+	t.Run("keys are scoped to their actor", func(t *testing.T) {
+		prev, err := env.store.ReserveIdempotencyKey(ctx, storage.IdempotencyKey{Actor: bob, Key: key.Key}, "viewer", expiresAt)
+		require.NoError(t, err)
+		assert.Nil(t, prev)
+	})
+
+	t.Run("responses are returned to retries", func(t *testing.T) {
+		resp := storage.IdempotentResponse{
+			Status: http.StatusOK,
+			Header: map[string]string{"Content-Type": "application/json", "ETag": `"1"`},
+			Body:   []byte(`{"name": "viewer"}`),
+		}
+
+		require.NoError(t, env.store.SaveIdempotentResponse(ctx, key, resp))
+
+		prev, err := env.store.ReserveIdempotencyKey(ctx, key, "viewer", expiresAt)
+		require.NoError(t, err)
+		require.NotNil(t, prev)
+		assert.Equal(t, &resp, prev.Response)
+
+		require.NoError(t, env.store.ReleaseIdempotencyKey(ctx, key))
+
+		prev, err = env.store.ReserveIdempotencyKey(ctx, key, "viewer", expiresAt)
+		require.NoError(t, err)
+		assert.NotNil(t, prev, "keys with a response aren't released")
+	})
+
+	t.Run("unreserved keys can't be saved", func(t *testing.T) {
+		err := env.store.SaveIdempotentResponse(ctx, storage.IdempotencyKey{Actor: alice, Key: "unknown"},
+			storage.IdempotentResponse{Status: http.StatusOK})
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("released keys can be reserved again", func(t *testing.T) {
+		released := storage.IdempotencyKey{Actor: alice, Key: "create-admin"}
+
+		prev, err := env.store.ReserveIdempotencyKey(ctx, released, "admin", expiresAt)
+		require.NoError(t, err)
+		require.Nil(t, prev)
+
+		require.NoError(t, env.store.ReleaseIdempotencyKey(ctx, released))
+
+		prev, err = env.store.ReserveIdempotencyKey(ctx, released, "admin", expiresAt)
+		require.NoError(t, err)
+		assert.Nil(t, prev)
+	})
+
+	t.Run("expired keys are reserved again and pruned", func(t *testing.T) {
+		expired := storage.IdempotencyKey{Actor: alice, Key: "create-editor"}
+
+		prev, err := env.store.ReserveIdempotencyKey(ctx, expired, "editor", time.Now().Add(-time.Minute))
+		require.NoError(t, err)
+		require.Nil(t, prev)
+
+		prev, err = env.store.ReserveIdempotencyKey(ctx, expired, "other", time.Now().Add(-time.Minute))
+		require.NoError(t, err)
+		assert.Nil(t, prev)
+
+		pruned, err := env.store.PruneIdempotencyKeys(ctx, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), pruned)
+
+		prev, err = env.store.ReserveIdempotencyKey(ctx, key, "viewer", expiresAt)
+		require.NoError(t, err)
+		assert.NotNil(t, prev, "keys that haven't expired are kept")
+	})
+}
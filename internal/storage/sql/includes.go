@@ -0,0 +1,185 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+	"github.com/infratographer/lmi/internal/storage"
+	"github.com/infratographer/lmi/internal/storage/sql/models"
+)
+
+func (drv *sqlDriver) GetRoleIncludes(c context.Context, id apiv1.EntityID) ([]*apiv1.RoleInfo, error) {
+	exists, err := models.RoleExists(c, drv.db, id.String())
+	if err != nil {
+		return nil, fmt.Errorf("couldn't find role: %w", err)
+	}
+
+	if !exists {
+		return nil, storage.ErrNotFound
+	}
+
+	r := &models.Role{ID: id.String()}
+
+	roles, err := r.IncludedRoleRoles(
+		qm.OrderBy(models.RoleTableColumns.Name),
+	).All(c, drv.db)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get included roles: %w", err)
+	}
+
+	rolesOut := make([]*apiv1.RoleInfo, len(roles))
+	for i, r := range roles {
+		roleID, err := apiv1.ParseEntityID(r.ID)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't parse role ID %s: %w", r.ID, err)
+		}
+
+		rolesOut[i] = &apiv1.RoleInfo{
+			Id:          roleID,
+			Name:        r.Name,
+			Description: &r.Description,
+			Directory:   r.DirectoryID.Ptr(),
+			Version:     &r.Version,
+			CreatedAt:   r.CreatedAt,
+			UpdatedAt:   r.UpdatedAt,
+		}
+	}
+
+	return rolesOut, nil
+}
+
+func (drv *sqlDriver) AddRoleInclude(
+	c context.Context,
+	id apiv1.EntityID,
+	included apiv1.RoleIdentifier,
+	ifVersion *int64,
+) (int64, error) {
+	var version int64
+
+	err := drv.executeTx(c, func(tx *txn) error {
+		r, err := findRoleAtVersion(c, tx, id, ifVersion)
+		if err != nil {
+			return err
+		}
+
+		version = r.Version
+
+		inc, err := models.FindRole(c, tx, included.Id.String())
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				err = storage.ErrNotFound
+			}
+			return fmt.Errorf("couldn't find included role: %w", err)
+		}
+
+		// A role defined in a directory can only be included by roles
+		// defined in its subtree, or it could be assigned outside of it.
+		if inc.DirectoryID.Valid {
+			ok := false
+			if r.DirectoryID.Valid {
+				ok, err = inSubtree(c, tx, inc.DirectoryID.String, r.DirectoryID.String)
+				if err != nil {
+					return err
+				}
+			}
+
+			if !ok {
+				return fmt.Errorf("role %s can't be included by role %s: %w", inc.ID, r.ID, storage.ErrRoleNotInScope)
+			}
+		}
+
+		cyclic, err := includesRole(c, tx, inc.ID, r.ID)
+		if err != nil {
+			return err
+		}
+
+		if cyclic {
+			return fmt.Errorf("role %s includes role %s: %w", inc.ID, r.ID, storage.ErrRoleCycle)
+		}
+
+		// check if the role is already included
+		incExists, err := r.IncludedRoleRoles(
+			models.RoleWhere.ID.EQ(inc.ID),
+		).Exists(c, tx)
+		if err != nil {
+			return fmt.Errorf("couldn't check if role is included: %w", err)
+		}
+
+		if incExists {
+			return nil
+		}
+
+		if err := r.AddIncludedRoleRoles(c, tx, false, inc); err != nil {
+			return fmt.Errorf("couldn't include role: %w", err)
+		}
+
+		if err := bumpRoleVersion(c, tx, r); err != nil {
+			return err
+		}
+
+		version = r.Version
+
+		if err := recordRoleInclude(tx, apiv1.EventRoleIncludeAdded, r, included); err != nil {
+			return err
+		}
+
+		return refreshRoleEffectivePermissions(c, tx, r.ID)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return version, nil
+}
+
+func (drv *sqlDriver) RemoveRoleInclude(
+	c context.Context,
+	id apiv1.EntityID,
+	included apiv1.RoleIdentifier,
+	ifVersion *int64,
+) (int64, error) {
+	var version int64
+
+	err := drv.executeTx(c, func(tx *txn) error {
+		r, err := findRoleAtVersion(c, tx, id, ifVersion)
+		if err != nil {
+			return err
+		}
+
+		inc, err := r.IncludedRoleRoles(
+			models.RoleWhere.ID.EQ(included.Id.String()),
+		).One(c, tx)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return storage.ErrNotFound
+			}
+			return fmt.Errorf("couldn't get included role: %w", err)
+		}
+
+		if err := r.RemoveIncludedRoleRoles(c, tx, inc); err != nil {
+			return fmt.Errorf("couldn't remove included role: %w", err)
+		}
+
+		if err := bumpRoleVersion(c, tx, r); err != nil {
+			return err
+		}
+
+		version = r.Version
+
+		if err := recordRoleInclude(tx, apiv1.EventRoleIncludeRemoved, r, included); err != nil {
+			return err
+		}
+
+		return refreshRoleEffectivePermissions(c, tx, r.ID)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return version, nil
+}
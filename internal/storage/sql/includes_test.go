@@ -0,0 +1,96 @@
+package sql_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+	"github.com/infratographer/lmi/internal/storage"
+	sqlstore "github.com/infratographer/lmi/internal/storage/sql"
+)
+
+func TestRoleIncludes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	env := newTestEnv(t, sqlstore.WithAutoTrackSubjects(true))
+
+	root := env.addDirectory(ctx, t, nil)
+
+	admin := env.createRole(ctx, t, apiv1.NewRole{Name: "admin"}, "instances.delete")
+	editor := env.createRole(ctx, t, apiv1.NewRole{Name: "editor"}, "instances.update")
+	viewer := env.createRole(ctx, t, apiv1.NewRole{Name: "viewer"}, "instances.get")
+
+	include := func(t *testing.T, id, included apiv1.EntityID) {
+		t.Helper()
+
+		_, err := env.store.AddRoleInclude(ctx, id, apiv1.RoleIdentifier{Id: included}, nil)
+		require.NoError(t, err)
+	}
+
+	include(t, admin, editor)
+	include(t, editor, viewer)
+
+	subject := newSubject()
+	env.assignRole(ctx, t, admin, subject, root)
+
+	t.Run("included roles are granted transitively", func(t *testing.T) {
+		for _, target := range []string{"instances.delete", "instances.update", "instances.get"} {
+			res := env.check(ctx, t, subject, target, root)
+			assert.True(t, res.Allowed, target)
+			assert.Equal(t, &admin, res.Role, target)
+		}
+	})
+
+	t.Run("cycles are rejected", func(t *testing.T) {
+		_, err := env.store.AddRoleInclude(ctx, viewer, apiv1.RoleIdentifier{Id: admin}, nil)
+		assert.ErrorIs(t, err, storage.ErrRoleCycle)
+
+		_, err = env.store.AddRoleInclude(ctx, editor, apiv1.RoleIdentifier{Id: editor}, nil)
+		assert.ErrorIs(t, err, storage.ErrRoleCycle)
+
+		included, err := env.store.GetRoleIncludes(ctx, viewer)
+		require.NoError(t, err)
+		assert.Empty(t, included)
+	})
+
+	t.Run("changes to included roles refresh their includers", func(t *testing.T) {
+		env.addRolePermission(ctx, t, viewer, "instances.list")
+
+		res := env.check(ctx, t, subject, "instances.list", root)
+		assert.True(t, res.Allowed)
+		assert.Equal(t, &admin, res.Role)
+	})
+
+	t.Run("removing an include revokes what it granted", func(t *testing.T) {
+		_, err := env.store.RemoveRoleInclude(ctx, admin, apiv1.RoleIdentifier{Id: editor}, nil)
+		require.NoError(t, err)
+
+		assert.True(t, env.check(ctx, t, subject, "instances.delete", root).Allowed)
+
+		for _, target := range []string{"instances.update", "instances.get", "instances.list"} {
+			assert.False(t, env.check(ctx, t, subject, target, root).Allowed, target)
+		}
+	})
+
+	t.Run("includes change the role's version", func(t *testing.T) {
+		role, err := env.store.GetRole(ctx, admin)
+		require.NoError(t, err)
+
+		stale := *role.Version
+
+		version, err := env.store.AddRoleInclude(ctx, admin, apiv1.RoleIdentifier{Id: viewer}, &stale)
+		require.NoError(t, err)
+		assert.Equal(t, stale+1, version)
+
+		_, err = env.store.RemoveRoleInclude(ctx, admin, apiv1.RoleIdentifier{Id: viewer}, &stale)
+		assert.ErrorIs(t, err, storage.ErrVersionMismatch)
+
+		version, err = env.store.RemoveRoleInclude(ctx, admin, apiv1.RoleIdentifier{Id: viewer}, &version)
+		require.NoError(t, err)
+		assert.Equal(t, stale+2, version)
+	})
+}
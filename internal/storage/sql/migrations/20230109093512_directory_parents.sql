@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- directory_parents table
+-- It stores the parent of every tracked directory so that
+-- permissions assigned on a directory can be inherited by
+-- its descendants. The parent may not be tracked itself
+-- (e.g. the parent of the base directory), so it's not
+-- a foreign key.
+CREATE TABLE IF NOT EXISTS directory_parents (
+    directory_id UUID NOT NULL PRIMARY KEY,
+    parent_id UUID,
+    FOREIGN KEY (directory_id) REFERENCES tracked_directories(id) ON DELETE CASCADE,
+    INDEX (parent_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS directory_parents;
+-- +goose StatementEnd
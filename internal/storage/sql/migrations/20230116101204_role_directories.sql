@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Role names weren't unique before, but global ones are now.
+-- The oldest role keeps its name and the others are renamed
+-- after their ID, so they can still be told apart.
+UPDATE roles SET name = name || '-' || id::STRING
+WHERE id IN (
+    SELECT id FROM (
+        SELECT id, row_number() OVER (PARTITION BY name ORDER BY created_at, id) AS n
+        FROM roles
+    ) AS ranked
+    WHERE n > 1
+);
+
+-- roles.directory_id column
+-- It stores the directory where a role is defined. Roles
+-- defined in a directory can only be seen and assigned in
+-- its subtree, while roles without a directory are global.
+-- Role names are unique per directory, so a directory may
+-- define a role with the same name as a global one.
+ALTER TABLE roles ADD COLUMN IF NOT EXISTS directory_id UUID NULL
+    REFERENCES tracked_directories(id) ON DELETE CASCADE;
+
+CREATE UNIQUE INDEX IF NOT EXISTS roles_directory_id_name_key ON roles (directory_id, name)
+    WHERE directory_id IS NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS roles_global_name_key ON roles (name)
+    WHERE directory_id IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS roles@roles_global_name_key;
+DROP INDEX IF EXISTS roles@roles_directory_id_name_key;
+ALTER TABLE roles DROP COLUMN IF EXISTS directory_id;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- role_includes table
+-- It stores the roles included by other roles. A role
+-- grants its own permissions as well as the permissions
+-- of every role it includes, directly or not.
+CREATE TABLE IF NOT EXISTS role_includes (
+    role_id UUID NOT NULL,
+    included_role_id UUID NOT NULL,
+    PRIMARY KEY (role_id, included_role_id),
+    FOREIGN KEY (role_id) REFERENCES roles(id) ON DELETE CASCADE,
+    FOREIGN KEY (included_role_id) REFERENCES roles(id) ON DELETE CASCADE,
+    INDEX (included_role_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS role_includes;
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- deny_rules table
+-- It stores the targets a subject, or every subject holding
+-- a role, is denied on a scope and its subtree. Denies take
+-- precedence over the permissions granted by roles. The
+-- target may be a wildcard or not registered yet, so it's
+-- not a foreign key.
+CREATE TABLE IF NOT EXISTS deny_rules (
+    id UUID NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    subject_id TEXT NULL,
+    role_id UUID NULL,
+    target TEXT NOT NULL,
+    scope UUID NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (subject_id) REFERENCES tracked_subjects(subject_id) ON DELETE CASCADE,
+    FOREIGN KEY (role_id) REFERENCES roles(id) ON DELETE CASCADE,
+    FOREIGN KEY (scope) REFERENCES tracked_directories(id) ON DELETE CASCADE,
+    CHECK ((subject_id IS NULL) != (role_id IS NULL)),
+    INDEX (scope),
+    INDEX (subject_id),
+    INDEX (role_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS deny_rules;
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- role_assignments.not_before and role_assignments.expires_at columns
+-- They bound the time during which a role assignment is active.
+-- Inactive assignments grant no effective permissions.
+ALTER TABLE role_assignments ADD COLUMN IF NOT EXISTS not_before TIMESTAMPTZ NULL;
+ALTER TABLE role_assignments ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ NULL;
+
+CREATE INDEX IF NOT EXISTS role_assignments_not_before_idx ON role_assignments (not_before)
+    WHERE not_before IS NOT NULL;
+
+-- effective_permissions.expires_at column
+-- It stores when the last of the assignments granting the
+-- effective permission expires, if they all do, so that
+-- lapsed permissions can be ignored and swept.
+ALTER TABLE effective_permissions ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ NULL;
+
+CREATE INDEX IF NOT EXISTS effective_permissions_expires_at_idx ON effective_permissions (expires_at)
+    WHERE expires_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS effective_permissions@effective_permissions_expires_at_idx;
+ALTER TABLE effective_permissions DROP COLUMN IF EXISTS expires_at;
+DROP INDEX IF EXISTS role_assignments@role_assignments_not_before_idx;
+ALTER TABLE role_assignments DROP COLUMN IF EXISTS expires_at;
+ALTER TABLE role_assignments DROP COLUMN IF EXISTS not_before;
+-- +goose StatementEnd
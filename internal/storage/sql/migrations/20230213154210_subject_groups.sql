@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- subject_groups table
+-- It stores the tracked subjects that are groups of other
+-- subjects. Roles assigned to a group apply to all of its
+-- members, directly or through nested groups.
+CREATE TABLE IF NOT EXISTS subject_groups (
+    subject_id TEXT NOT NULL PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE,
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (subject_id) REFERENCES tracked_subjects(subject_id) ON DELETE CASCADE
+);
+
+-- group_members table
+-- It stores the direct members of every group. Members may
+-- be groups themselves.
+CREATE TABLE IF NOT EXISTS group_members (
+    group_id TEXT NOT NULL,
+    member_id TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (group_id, member_id),
+    FOREIGN KEY (group_id) REFERENCES subject_groups(subject_id) ON DELETE CASCADE,
+    FOREIGN KEY (member_id) REFERENCES tracked_subjects(subject_id) ON DELETE CASCADE,
+    INDEX (member_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS group_members;
+DROP TABLE IF EXISTS subject_groups;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- event_outbox table
+-- It stores the events describing the changes made to LMI. They're
+-- written in the same transaction as the changes, and relayed to NATS
+-- in order afterwards, so that no event is lost or sent for a change
+-- that was rolled back. Delivered events are pruned after a while.
+CREATE TABLE IF NOT EXISTS event_outbox (
+    id INT8 NOT NULL PRIMARY KEY DEFAULT unique_rowid(),
+    event_type TEXT NOT NULL,
+    payload JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    attempts INT8 NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    delivered_at TIMESTAMPTZ NULL
+);
+
+CREATE INDEX IF NOT EXISTS event_outbox_pending_idx ON event_outbox (id)
+    WHERE delivered_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS event_outbox_delivered_at_idx ON event_outbox (delivered_at)
+    WHERE delivered_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS event_outbox;
+-- +goose StatementEnd
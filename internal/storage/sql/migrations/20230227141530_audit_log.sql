@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- audit_log table
+-- It records every change made to LMI, who made it and the state of the
+-- objects involved before and after it. Entries are written in the same
+-- transaction as the changes and are never updated or deleted.
+CREATE TABLE IF NOT EXISTS audit_log (
+    id INT8 NOT NULL PRIMARY KEY DEFAULT unique_rowid(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    actor TEXT NULL,
+    action TEXT NOT NULL,
+    objects STRING[] NOT NULL,
+    before JSONB NULL,
+    after JSONB NULL
+);
+
+CREATE INDEX IF NOT EXISTS audit_log_created_at_idx ON audit_log (created_at);
+
+CREATE INDEX IF NOT EXISTS audit_log_actor_idx ON audit_log (actor);
+
+CREATE INDEX IF NOT EXISTS audit_log_action_idx ON audit_log (action);
+
+CREATE INVERTED INDEX IF NOT EXISTS audit_log_objects_idx ON audit_log (objects);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS audit_log;
+-- +goose StatementEnd
@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Lists are paginated on the column they're sorted on, with the table's
+-- key breaking ties. These indexes let pages be read without scanning
+-- and sorting every row.
+CREATE INDEX IF NOT EXISTS roles_name_idx ON roles (name, id);
+
+CREATE INDEX IF NOT EXISTS roles_created_at_idx ON roles (created_at, id);
+
+CREATE INDEX IF NOT EXISTS roles_updated_at_idx ON roles (updated_at, id);
+
+CREATE INDEX IF NOT EXISTS permissions_created_at_idx ON permissions (created_at, target);
+
+CREATE INDEX IF NOT EXISTS permissions_updated_at_idx ON permissions (updated_at, target);
+
+CREATE INDEX IF NOT EXISTS role_assignments_role_subject_idx ON role_assignments (role_id, subject_id, id);
+
+CREATE INDEX IF NOT EXISTS role_assignments_role_scope_idx ON role_assignments (role_id, scope, id);
+
+CREATE INDEX IF NOT EXISTS role_assignments_role_created_at_idx ON role_assignments (role_id, created_at, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS role_assignments@role_assignments_role_created_at_idx;
+DROP INDEX IF EXISTS role_assignments@role_assignments_role_scope_idx;
+DROP INDEX IF EXISTS role_assignments@role_assignments_role_subject_idx;
+DROP INDEX IF EXISTS permissions@permissions_updated_at_idx;
+DROP INDEX IF EXISTS permissions@permissions_created_at_idx;
+DROP INDEX IF EXISTS roles@roles_updated_at_idx;
+DROP INDEX IF EXISTS roles@roles_created_at_idx;
+DROP INDEX IF EXISTS roles@roles_name_idx;
+-- +goose StatementEnd
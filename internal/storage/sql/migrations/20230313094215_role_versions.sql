@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- version of roles
+-- It's incremented whenever a role or its permissions change, so that
+-- changes can be made conditional on the version they're based on.
+ALTER TABLE roles ADD COLUMN IF NOT EXISTS version INT8 NOT NULL DEFAULT 1;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE roles DROP COLUMN IF EXISTS version;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- idempotency_keys table
+-- It stores the responses to the requests made with an Idempotency-Key,
+-- so that retried requests get the original response instead of being
+-- applied again. A key is reserved while its request is processed, when
+-- it has no response yet, and is kept until it expires.
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    actor TEXT NOT NULL,
+    key TEXT NOT NULL,
+    fingerprint TEXT NOT NULL,
+    status INT8 NULL,
+    header JSONB NULL,
+    body BYTES NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (actor, key)
+);
+
+CREATE INDEX IF NOT EXISTS idempotency_keys_expires_at_idx ON idempotency_keys (expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS idempotency_keys;
+-- +goose StatementEnd
@@ -2,10 +2,14 @@ package migrations_test
 
 import (
 	"database/sql"
+	"os"
 	"testing"
 
 	"github.com/cockroachdb/cockroach-go/v2/testserver"
+	appsqlmig "github.com/infratographer/fertilesoil/app/v1/sql/migrations"
+	"github.com/pressly/goose/v3"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/infratographer/lmi/internal/storage/sql/migrations"
 )
@@ -24,3 +28,51 @@ func TestMigrations(t *testing.T) {
 	err := migrations.Migrate(dbConn)
 	assert.NoError(t, err, "failed to run migrations")
 }
+
+// TestMigrateDuplicateRoleNames checks that roles sharing a name before
+// names were made unique are renamed rather than failing the migration.
+// It doesn't run in parallel since goose isn't thread-safe.
+func TestMigrateDuplicateRoleNames(t *testing.T) {
+	ts, err := testserver.NewTestServer()
+	require.NoError(t, err)
+	defer ts.Stop()
+
+	db, err := sql.Open("postgres", ts.PGURL().String())
+	require.NoError(t, err, "failed to open db connection")
+
+	require.NoError(t, goose.SetDialect("postgres"))
+	require.NoError(t, appsqlmig.BootStrap("postgres", db))
+
+	// migrate up to right before role names are made unique
+	goose.SetBaseFS(os.DirFS("."))
+	require.NoError(t, goose.UpTo(db, ".", 20230109093512))
+
+	_, err = db.Exec(`INSERT INTO roles (id, name) VALUES
+('00000000-0000-0000-0000-000000000001', 'viewer'),
+('00000000-0000-0000-0000-000000000002', 'viewer'),
+('00000000-0000-0000-0000-000000000003', 'editor')`)
+	require.NoError(t, err)
+
+	require.NoError(t, migrations.Migrate(db), "failed to run migrations")
+
+	rows, err := db.Query("SELECT name FROM roles ORDER BY id")
+	require.NoError(t, err)
+	defer rows.Close()
+
+	names := []string{}
+
+	for rows.Next() {
+		var name string
+		require.NoError(t, rows.Scan(&name))
+
+		names = append(names, name)
+	}
+
+	require.NoError(t, rows.Err())
+
+	assert.Equal(t, []string{
+		"viewer",
+		"viewer-00000000-0000-0000-0000-000000000002",
+		"editor",
+	}, names)
+}
@@ -4,21 +4,37 @@
 package models
 
 var TableNames = struct {
+	AuditLog             string
+	DenyRules            string
+	DirectoryParents     string
 	EffectivePermissions string
+	EventOutbox          string
 	GooseDBVersion       string
+	GroupMembers         string
+	IdempotencyKeys      string
 	Permissions          string
 	RoleAssignments      string
+	RoleIncludes         string
 	RolePermissions      string
 	Roles                string
+	SubjectGroups        string
 	TrackedDirectories   string
 	TrackedSubjects      string
 }{
+	AuditLog:             "audit_log",
+	DenyRules:            "deny_rules",
+	DirectoryParents:     "directory_parents",
 	EffectivePermissions: "effective_permissions",
+	EventOutbox:          "event_outbox",
 	GooseDBVersion:       "goose_db_version",
+	GroupMembers:         "group_members",
+	IdempotencyKeys:      "idempotency_keys",
 	Permissions:          "permissions",
 	RoleAssignments:      "role_assignments",
+	RoleIncludes:         "role_includes",
 	RolePermissions:      "role_permissions",
 	Roles:                "roles",
+	SubjectGroups:        "subject_groups",
 	TrackedDirectories:   "tracked_directories",
 	TrackedSubjects:      "tracked_subjects",
 }
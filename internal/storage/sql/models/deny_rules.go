@@ -0,0 +1,1578 @@
+// Code generated by SQLBoiler 4.14.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// DenyRule is an object representing the database table.
+type DenyRule struct {
+	ID        string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	SubjectID null.String `boil:"subject_id" json:"subject_id,omitempty" toml:"subject_id" yaml:"subject_id,omitempty"`
+	RoleID    null.String `boil:"role_id" json:"role_id,omitempty" toml:"role_id" yaml:"role_id,omitempty"`
+	Target    string      `boil:"target" json:"target" toml:"target" yaml:"target"`
+	Scope     string      `boil:"scope" json:"scope" toml:"scope" yaml:"scope"`
+	Reason    string      `boil:"reason" json:"reason" toml:"reason" yaml:"reason"`
+	CreatedAt time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *denyRuleR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L denyRuleL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DenyRuleColumns = struct {
+	ID        string
+	SubjectID string
+	RoleID    string
+	Target    string
+	Scope     string
+	Reason    string
+	CreatedAt string
+}{
+	ID:        "id",
+	SubjectID: "subject_id",
+	RoleID:    "role_id",
+	Target:    "target",
+	Scope:     "scope",
+	Reason:    "reason",
+	CreatedAt: "created_at",
+}
+
+var DenyRuleTableColumns = struct {
+	ID        string
+	SubjectID string
+	RoleID    string
+	Target    string
+	Scope     string
+	Reason    string
+	CreatedAt string
+}{
+	ID:        "deny_rules.id",
+	SubjectID: "deny_rules.subject_id",
+	RoleID:    "deny_rules.role_id",
+	Target:    "deny_rules.target",
+	Scope:     "deny_rules.scope",
+	Reason:    "deny_rules.reason",
+	CreatedAt: "deny_rules.created_at",
+}
+
+// Generated where
+
+var DenyRuleWhere = struct {
+	ID        whereHelperstring
+	SubjectID whereHelpernull_String
+	RoleID    whereHelpernull_String
+	Target    whereHelperstring
+	Scope     whereHelperstring
+	Reason    whereHelperstring
+	CreatedAt whereHelpertime_Time
+}{
+	ID:        whereHelperstring{field: "\"deny_rules\".\"id\""},
+	SubjectID: whereHelpernull_String{field: "\"deny_rules\".\"subject_id\""},
+	RoleID:    whereHelpernull_String{field: "\"deny_rules\".\"role_id\""},
+	Target:    whereHelperstring{field: "\"deny_rules\".\"target\""},
+	Scope:     whereHelperstring{field: "\"deny_rules\".\"scope\""},
+	Reason:    whereHelperstring{field: "\"deny_rules\".\"reason\""},
+	CreatedAt: whereHelpertime_Time{field: "\"deny_rules\".\"created_at\""},
+}
+
+// DenyRuleRels is where relationship names are stored.
+var DenyRuleRels = struct {
+	Subject               string
+	ScopeTrackedDirectory string
+	Role                  string
+}{
+	Subject:               "Subject",
+	ScopeTrackedDirectory: "ScopeTrackedDirectory",
+	Role:                  "Role",
+}
+
+// denyRuleR is where relationships are stored.
+type denyRuleR struct {
+	Subject               *TrackedSubject   `boil:"Subject" json:"Subject" toml:"Subject" yaml:"Subject"`
+	ScopeTrackedDirectory *TrackedDirectory `boil:"ScopeTrackedDirectory" json:"ScopeTrackedDirectory" toml:"ScopeTrackedDirectory" yaml:"ScopeTrackedDirectory"`
+	Role                  *Role             `boil:"Role" json:"Role" toml:"Role" yaml:"Role"`
+}
+
+// NewStruct creates a new relationship struct
+func (*denyRuleR) NewStruct() *denyRuleR {
+	return &denyRuleR{}
+}
+
+func (r *denyRuleR) GetSubject() *TrackedSubject {
+	if r == nil {
+		return nil
+	}
+	return r.Subject
+}
+
+func (r *denyRuleR) GetScopeTrackedDirectory() *TrackedDirectory {
+	if r == nil {
+		return nil
+	}
+	return r.ScopeTrackedDirectory
+}
+
+func (r *denyRuleR) GetRole() *Role {
+	if r == nil {
+		return nil
+	}
+	return r.Role
+}
+
+// denyRuleL is where Load methods for each relationship are stored.
+type denyRuleL struct{}
+
+var (
+	denyRuleAllColumns            = []string{"id", "subject_id", "role_id", "target", "scope", "reason", "created_at"}
+	denyRuleColumnsWithoutDefault = []string{"target", "scope"}
+	denyRuleColumnsWithDefault    = []string{"id", "subject_id", "role_id", "reason", "created_at"}
+	denyRulePrimaryKeyColumns     = []string{"id"}
+	denyRuleGeneratedColumns      = []string{}
+)
+
+type (
+	// DenyRuleSlice is an alias for a slice of pointers to DenyRule.
+	// This should almost always be used instead of []DenyRule.
+	DenyRuleSlice []*DenyRule
+	// DenyRuleHook is the signature for custom DenyRule hook methods
+	DenyRuleHook func(context.Context, boil.ContextExecutor, *DenyRule) error
+
+	denyRuleQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	denyRuleType                 = reflect.TypeOf(&DenyRule{})
+	denyRuleMapping              = queries.MakeStructMapping(denyRuleType)
+	denyRulePrimaryKeyMapping, _ = queries.BindMapping(denyRuleType, denyRuleMapping, denyRulePrimaryKeyColumns)
+	denyRuleInsertCacheMut       sync.RWMutex
+	denyRuleInsertCache          = make(map[string]insertCache)
+	denyRuleUpdateCacheMut       sync.RWMutex
+	denyRuleUpdateCache          = make(map[string]updateCache)
+	denyRuleUpsertCacheMut       sync.RWMutex
+	denyRuleUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var denyRuleAfterSelectHooks []DenyRuleHook
+
+var denyRuleBeforeInsertHooks []DenyRuleHook
+var denyRuleAfterInsertHooks []DenyRuleHook
+
+var denyRuleBeforeUpdateHooks []DenyRuleHook
+var denyRuleAfterUpdateHooks []DenyRuleHook
+
+var denyRuleBeforeDeleteHooks []DenyRuleHook
+var denyRuleAfterDeleteHooks []DenyRuleHook
+
+var denyRuleBeforeUpsertHooks []DenyRuleHook
+var denyRuleAfterUpsertHooks []DenyRuleHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *DenyRule) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range denyRuleAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *DenyRule) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range denyRuleBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *DenyRule) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range denyRuleAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *DenyRule) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range denyRuleBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *DenyRule) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range denyRuleAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *DenyRule) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range denyRuleBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *DenyRule) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range denyRuleAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *DenyRule) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range denyRuleBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *DenyRule) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range denyRuleAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddDenyRuleHook registers your hook function for all future operations.
+func AddDenyRuleHook(hookPoint boil.HookPoint, denyRuleHook DenyRuleHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		denyRuleAfterSelectHooks = append(denyRuleAfterSelectHooks, denyRuleHook)
+	case boil.BeforeInsertHook:
+		denyRuleBeforeInsertHooks = append(denyRuleBeforeInsertHooks, denyRuleHook)
+	case boil.AfterInsertHook:
+		denyRuleAfterInsertHooks = append(denyRuleAfterInsertHooks, denyRuleHook)
+	case boil.BeforeUpdateHook:
+		denyRuleBeforeUpdateHooks = append(denyRuleBeforeUpdateHooks, denyRuleHook)
+	case boil.AfterUpdateHook:
+		denyRuleAfterUpdateHooks = append(denyRuleAfterUpdateHooks, denyRuleHook)
+	case boil.BeforeDeleteHook:
+		denyRuleBeforeDeleteHooks = append(denyRuleBeforeDeleteHooks, denyRuleHook)
+	case boil.AfterDeleteHook:
+		denyRuleAfterDeleteHooks = append(denyRuleAfterDeleteHooks, denyRuleHook)
+	case boil.BeforeUpsertHook:
+		denyRuleBeforeUpsertHooks = append(denyRuleBeforeUpsertHooks, denyRuleHook)
+	case boil.AfterUpsertHook:
+		denyRuleAfterUpsertHooks = append(denyRuleAfterUpsertHooks, denyRuleHook)
+	}
+}
+
+// One returns a single denyRule record from the query.
+func (q denyRuleQuery) One(ctx context.Context, exec boil.ContextExecutor) (*DenyRule, error) {
+	o := &DenyRule{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to execute a one query for deny_rules")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all DenyRule records from the query.
+func (q denyRuleQuery) All(ctx context.Context, exec boil.ContextExecutor) (DenyRuleSlice, error) {
+	var o []*DenyRule
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to DenyRule slice")
+	}
+
+	if len(denyRuleAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all DenyRule records in the query.
+func (q denyRuleQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count deny_rules rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q denyRuleQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if deny_rules exists")
+	}
+
+	return count > 0, nil
+}
+
+// Subject pointed to by the foreign key.
+func (o *DenyRule) Subject(mods ...qm.QueryMod) trackedSubjectQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"subject_id\" = ?", o.SubjectID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return TrackedSubjects(queryMods...)
+}
+
+// ScopeTrackedDirectory pointed to by the foreign key.
+func (o *DenyRule) ScopeTrackedDirectory(mods ...qm.QueryMod) trackedDirectoryQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.Scope),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return TrackedDirectories(queryMods...)
+}
+
+// Role pointed to by the foreign key.
+func (o *DenyRule) Role(mods ...qm.QueryMod) roleQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.RoleID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Roles(queryMods...)
+}
+
+// LoadSubject allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (denyRuleL) LoadSubject(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDenyRule interface{}, mods queries.Applicator) error {
+	var slice []*DenyRule
+	var object *DenyRule
+
+	if singular {
+		var ok bool
+		object, ok = maybeDenyRule.(*DenyRule)
+		if !ok {
+			object = new(DenyRule)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDenyRule)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDenyRule))
+			}
+		}
+	} else {
+		s, ok := maybeDenyRule.(*[]*DenyRule)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDenyRule)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDenyRule))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &denyRuleR{}
+		}
+		if !queries.IsNil(object.SubjectID) {
+			args = append(args, object.SubjectID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &denyRuleR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.SubjectID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.SubjectID) {
+				args = append(args, obj.SubjectID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`tracked_subjects`),
+		qm.WhereIn(`tracked_subjects.subject_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load TrackedSubject")
+	}
+
+	var resultSlice []*TrackedSubject
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice TrackedSubject")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tracked_subjects")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tracked_subjects")
+	}
+
+	if len(trackedSubjectAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Subject = foreign
+		if foreign.R == nil {
+			foreign.R = &trackedSubjectR{}
+		}
+		foreign.R.SubjectDenyRules = append(foreign.R.SubjectDenyRules, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.SubjectID, foreign.SubjectID) {
+				local.R.Subject = foreign
+				if foreign.R == nil {
+					foreign.R = &trackedSubjectR{}
+				}
+				foreign.R.SubjectDenyRules = append(foreign.R.SubjectDenyRules, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadScopeTrackedDirectory allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (denyRuleL) LoadScopeTrackedDirectory(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDenyRule interface{}, mods queries.Applicator) error {
+	var slice []*DenyRule
+	var object *DenyRule
+
+	if singular {
+		var ok bool
+		object, ok = maybeDenyRule.(*DenyRule)
+		if !ok {
+			object = new(DenyRule)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDenyRule)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDenyRule))
+			}
+		}
+	} else {
+		s, ok := maybeDenyRule.(*[]*DenyRule)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDenyRule)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDenyRule))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &denyRuleR{}
+		}
+		args = append(args, object.Scope)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &denyRuleR{}
+			}
+
+			for _, a := range args {
+				if a == obj.Scope {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.Scope)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`tracked_directories`),
+		qm.WhereIn(`tracked_directories.id in ?`, args...),
+		qmhelper.WhereIsNull(`tracked_directories.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load TrackedDirectory")
+	}
+
+	var resultSlice []*TrackedDirectory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice TrackedDirectory")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tracked_directories")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tracked_directories")
+	}
+
+	if len(trackedDirectoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ScopeTrackedDirectory = foreign
+		if foreign.R == nil {
+			foreign.R = &trackedDirectoryR{}
+		}
+		foreign.R.ScopeDenyRules = append(foreign.R.ScopeDenyRules, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.Scope == foreign.ID {
+				local.R.ScopeTrackedDirectory = foreign
+				if foreign.R == nil {
+					foreign.R = &trackedDirectoryR{}
+				}
+				foreign.R.ScopeDenyRules = append(foreign.R.ScopeDenyRules, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRole allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (denyRuleL) LoadRole(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDenyRule interface{}, mods queries.Applicator) error {
+	var slice []*DenyRule
+	var object *DenyRule
+
+	if singular {
+		var ok bool
+		object, ok = maybeDenyRule.(*DenyRule)
+		if !ok {
+			object = new(DenyRule)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDenyRule)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDenyRule))
+			}
+		}
+	} else {
+		s, ok := maybeDenyRule.(*[]*DenyRule)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDenyRule)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDenyRule))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &denyRuleR{}
+		}
+		if !queries.IsNil(object.RoleID) {
+			args = append(args, object.RoleID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &denyRuleR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.RoleID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.RoleID) {
+				args = append(args, obj.RoleID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`roles`),
+		qm.WhereIn(`roles.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Role")
+	}
+
+	var resultSlice []*Role
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Role")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for roles")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for roles")
+	}
+
+	if len(roleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Role = foreign
+		if foreign.R == nil {
+			foreign.R = &roleR{}
+		}
+		foreign.R.DenyRules = append(foreign.R.DenyRules, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.RoleID, foreign.ID) {
+				local.R.Role = foreign
+				if foreign.R == nil {
+					foreign.R = &roleR{}
+				}
+				foreign.R.DenyRules = append(foreign.R.DenyRules, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetSubject of the denyRule to the related item.
+// Sets o.R.Subject to related.
+// Adds o to related.R.SubjectDenyRules.
+func (o *DenyRule) SetSubject(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TrackedSubject) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"deny_rules\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"subject_id"}),
+		strmangle.WhereClause("\"", "\"", 2, denyRulePrimaryKeyColumns),
+	)
+	values := []interface{}{related.SubjectID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.SubjectID, related.SubjectID)
+	if o.R == nil {
+		o.R = &denyRuleR{
+			Subject: related,
+		}
+	} else {
+		o.R.Subject = related
+	}
+
+	if related.R == nil {
+		related.R = &trackedSubjectR{
+			SubjectDenyRules: DenyRuleSlice{o},
+		}
+	} else {
+		related.R.SubjectDenyRules = append(related.R.SubjectDenyRules, o)
+	}
+
+	return nil
+}
+
+// RemoveSubject relationship.
+// Sets o.R.Subject to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *DenyRule) RemoveSubject(ctx context.Context, exec boil.ContextExecutor, related *TrackedSubject) error {
+	var err error
+
+	queries.SetScanner(&o.SubjectID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("subject_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Subject = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.SubjectDenyRules {
+		if queries.Equal(o.SubjectID, ri.SubjectID) {
+			continue
+		}
+
+		ln := len(related.R.SubjectDenyRules)
+		if ln > 1 && i < ln-1 {
+			related.R.SubjectDenyRules[i] = related.R.SubjectDenyRules[ln-1]
+		}
+		related.R.SubjectDenyRules = related.R.SubjectDenyRules[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetScopeTrackedDirectory of the denyRule to the related item.
+// Sets o.R.ScopeTrackedDirectory to related.
+// Adds o to related.R.ScopeDenyRules.
+func (o *DenyRule) SetScopeTrackedDirectory(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TrackedDirectory) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"deny_rules\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"scope"}),
+		strmangle.WhereClause("\"", "\"", 2, denyRulePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.Scope = related.ID
+	if o.R == nil {
+		o.R = &denyRuleR{
+			ScopeTrackedDirectory: related,
+		}
+	} else {
+		o.R.ScopeTrackedDirectory = related
+	}
+
+	if related.R == nil {
+		related.R = &trackedDirectoryR{
+			ScopeDenyRules: DenyRuleSlice{o},
+		}
+	} else {
+		related.R.ScopeDenyRules = append(related.R.ScopeDenyRules, o)
+	}
+
+	return nil
+}
+
+// SetRole of the denyRule to the related item.
+// Sets o.R.Role to related.
+// Adds o to related.R.DenyRules.
+func (o *DenyRule) SetRole(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Role) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"deny_rules\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"role_id"}),
+		strmangle.WhereClause("\"", "\"", 2, denyRulePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.RoleID, related.ID)
+	if o.R == nil {
+		o.R = &denyRuleR{
+			Role: related,
+		}
+	} else {
+		o.R.Role = related
+	}
+
+	if related.R == nil {
+		related.R = &roleR{
+			DenyRules: DenyRuleSlice{o},
+		}
+	} else {
+		related.R.DenyRules = append(related.R.DenyRules, o)
+	}
+
+	return nil
+}
+
+// RemoveRole relationship.
+// Sets o.R.Role to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *DenyRule) RemoveRole(ctx context.Context, exec boil.ContextExecutor, related *Role) error {
+	var err error
+
+	queries.SetScanner(&o.RoleID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("role_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Role = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.DenyRules {
+		if queries.Equal(o.RoleID, ri.RoleID) {
+			continue
+		}
+
+		ln := len(related.R.DenyRules)
+		if ln > 1 && i < ln-1 {
+			related.R.DenyRules[i] = related.R.DenyRules[ln-1]
+		}
+		related.R.DenyRules = related.R.DenyRules[:ln-1]
+		break
+	}
+	return nil
+}
+
+// DenyRules retrieves all the records using an executor.
+func DenyRules(mods ...qm.QueryMod) denyRuleQuery {
+	mods = append(mods, qm.From("\"deny_rules\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"deny_rules\".*"})
+	}
+
+	return denyRuleQuery{q}
+}
+
+// FindDenyRule retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDenyRule(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*DenyRule, error) {
+	denyRuleObj := &DenyRule{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"deny_rules\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, denyRuleObj)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: unable to select from deny_rules")
+	}
+
+	if err = denyRuleObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return denyRuleObj, err
+	}
+
+	return denyRuleObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *DenyRule) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no deny_rules provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(denyRuleColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	denyRuleInsertCacheMut.RLock()
+	cache, cached := denyRuleInsertCache[key]
+	denyRuleInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			denyRuleAllColumns,
+			denyRuleColumnsWithDefault,
+			denyRuleColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(denyRuleType, denyRuleMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(denyRuleType, denyRuleMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"deny_rules\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"deny_rules\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into deny_rules")
+	}
+
+	if !cached {
+		denyRuleInsertCacheMut.Lock()
+		denyRuleInsertCache[key] = cache
+		denyRuleInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the DenyRule.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *DenyRule) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	denyRuleUpdateCacheMut.RLock()
+	cache, cached := denyRuleUpdateCache[key]
+	denyRuleUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			denyRuleAllColumns,
+			denyRulePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update deny_rules, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"deny_rules\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, denyRulePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(denyRuleType, denyRuleMapping, append(wl, denyRulePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update deny_rules row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for deny_rules")
+	}
+
+	if !cached {
+		denyRuleUpdateCacheMut.Lock()
+		denyRuleUpdateCache[key] = cache
+		denyRuleUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q denyRuleQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for deny_rules")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for deny_rules")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DenyRuleSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), denyRulePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"deny_rules\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, denyRulePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in denyRule slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all denyRule")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single DenyRule record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *DenyRule) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no DenyRule provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), denyRulePrimaryKeyMapping)
+	sql := "DELETE FROM \"deny_rules\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from deny_rules")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for deny_rules")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q denyRuleQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no denyRuleQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from deny_rules")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for deny_rules")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DenyRuleSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(denyRuleBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), denyRulePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"deny_rules\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, denyRulePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from denyRule slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for deny_rules")
+	}
+
+	if len(denyRuleAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *DenyRule) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDenyRule(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DenyRuleSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DenyRuleSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), denyRulePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"deny_rules\".* FROM \"deny_rules\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, denyRulePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in DenyRuleSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DenyRuleExists checks if the DenyRule row exists.
+func DenyRuleExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"deny_rules\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if deny_rules exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the DenyRule row exists.
+func (o *DenyRule) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return DenyRuleExists(ctx, exec, o.ID)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *DenyRule) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no deny_rules provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(denyRuleColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	denyRuleUpsertCacheMut.RLock()
+	cache, cached := denyRuleUpsertCache[key]
+	denyRuleUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			denyRuleAllColumns,
+			denyRuleColumnsWithDefault,
+			denyRuleColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			denyRuleAllColumns,
+			denyRulePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert deny_rules, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(denyRulePrimaryKeyColumns))
+			copy(conflict, denyRulePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryCockroachDB(dialect, "\"deny_rules\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(denyRuleType, denyRuleMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(denyRuleType, denyRuleMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		_, _ = fmt.Fprintln(boil.DebugWriter, cache.query)
+		_, _ = fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // CockcorachDB doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert deny_rules")
+	}
+
+	if !cached {
+		denyRuleUpsertCacheMut.Lock()
+		denyRuleUpsertCache[key] = cache
+		denyRuleUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
@@ -0,0 +1,1079 @@
+// Code generated by SQLBoiler 4.14.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// DirectoryParent is an object representing the database table.
+type DirectoryParent struct {
+	DirectoryID string      `boil:"directory_id" json:"directory_id" toml:"directory_id" yaml:"directory_id"`
+	ParentID    null.String `boil:"parent_id" json:"parent_id,omitempty" toml:"parent_id" yaml:"parent_id,omitempty"`
+
+	R *directoryParentR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L directoryParentL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DirectoryParentColumns = struct {
+	DirectoryID string
+	ParentID    string
+}{
+	DirectoryID: "directory_id",
+	ParentID:    "parent_id",
+}
+
+var DirectoryParentTableColumns = struct {
+	DirectoryID string
+	ParentID    string
+}{
+	DirectoryID: "directory_parents.directory_id",
+	ParentID:    "directory_parents.parent_id",
+}
+
+// Generated where
+
+var DirectoryParentWhere = struct {
+	DirectoryID whereHelperstring
+	ParentID    whereHelpernull_String
+}{
+	DirectoryID: whereHelperstring{field: "\"directory_parents\".\"directory_id\""},
+	ParentID:    whereHelpernull_String{field: "\"directory_parents\".\"parent_id\""},
+}
+
+// DirectoryParentRels is where relationship names are stored.
+var DirectoryParentRels = struct {
+	Directory string
+}{
+	Directory: "Directory",
+}
+
+// directoryParentR is where relationships are stored.
+type directoryParentR struct {
+	Directory *TrackedDirectory `boil:"Directory" json:"Directory" toml:"Directory" yaml:"Directory"`
+}
+
+// NewStruct creates a new relationship struct
+func (*directoryParentR) NewStruct() *directoryParentR {
+	return &directoryParentR{}
+}
+
+func (r *directoryParentR) GetDirectory() *TrackedDirectory {
+	if r == nil {
+		return nil
+	}
+	return r.Directory
+}
+
+// directoryParentL is where Load methods for each relationship are stored.
+type directoryParentL struct{}
+
+var (
+	directoryParentAllColumns            = []string{"directory_id", "parent_id"}
+	directoryParentColumnsWithoutDefault = []string{"directory_id"}
+	directoryParentColumnsWithDefault    = []string{"parent_id"}
+	directoryParentPrimaryKeyColumns     = []string{"directory_id"}
+	directoryParentGeneratedColumns      = []string{}
+)
+
+type (
+	// DirectoryParentSlice is an alias for a slice of pointers to DirectoryParent.
+	// This should almost always be used instead of []DirectoryParent.
+	DirectoryParentSlice []*DirectoryParent
+	// DirectoryParentHook is the signature for custom DirectoryParent hook methods
+	DirectoryParentHook func(context.Context, boil.ContextExecutor, *DirectoryParent) error
+
+	directoryParentQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	directoryParentType                 = reflect.TypeOf(&DirectoryParent{})
+	directoryParentMapping              = queries.MakeStructMapping(directoryParentType)
+	directoryParentPrimaryKeyMapping, _ = queries.BindMapping(directoryParentType, directoryParentMapping, directoryParentPrimaryKeyColumns)
+	directoryParentInsertCacheMut       sync.RWMutex
+	directoryParentInsertCache          = make(map[string]insertCache)
+	directoryParentUpdateCacheMut       sync.RWMutex
+	directoryParentUpdateCache          = make(map[string]updateCache)
+	directoryParentUpsertCacheMut       sync.RWMutex
+	directoryParentUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var directoryParentAfterSelectHooks []DirectoryParentHook
+
+var directoryParentBeforeInsertHooks []DirectoryParentHook
+var directoryParentAfterInsertHooks []DirectoryParentHook
+
+var directoryParentBeforeUpdateHooks []DirectoryParentHook
+var directoryParentAfterUpdateHooks []DirectoryParentHook
+
+var directoryParentBeforeDeleteHooks []DirectoryParentHook
+var directoryParentAfterDeleteHooks []DirectoryParentHook
+
+var directoryParentBeforeUpsertHooks []DirectoryParentHook
+var directoryParentAfterUpsertHooks []DirectoryParentHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *DirectoryParent) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range directoryParentAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *DirectoryParent) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range directoryParentBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *DirectoryParent) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range directoryParentAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *DirectoryParent) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range directoryParentBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *DirectoryParent) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range directoryParentAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *DirectoryParent) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range directoryParentBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *DirectoryParent) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range directoryParentAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *DirectoryParent) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range directoryParentBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *DirectoryParent) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range directoryParentAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddDirectoryParentHook registers your hook function for all future operations.
+func AddDirectoryParentHook(hookPoint boil.HookPoint, directoryParentHook DirectoryParentHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		directoryParentAfterSelectHooks = append(directoryParentAfterSelectHooks, directoryParentHook)
+	case boil.BeforeInsertHook:
+		directoryParentBeforeInsertHooks = append(directoryParentBeforeInsertHooks, directoryParentHook)
+	case boil.AfterInsertHook:
+		directoryParentAfterInsertHooks = append(directoryParentAfterInsertHooks, directoryParentHook)
+	case boil.BeforeUpdateHook:
+		directoryParentBeforeUpdateHooks = append(directoryParentBeforeUpdateHooks, directoryParentHook)
+	case boil.AfterUpdateHook:
+		directoryParentAfterUpdateHooks = append(directoryParentAfterUpdateHooks, directoryParentHook)
+	case boil.BeforeDeleteHook:
+		directoryParentBeforeDeleteHooks = append(directoryParentBeforeDeleteHooks, directoryParentHook)
+	case boil.AfterDeleteHook:
+		directoryParentAfterDeleteHooks = append(directoryParentAfterDeleteHooks, directoryParentHook)
+	case boil.BeforeUpsertHook:
+		directoryParentBeforeUpsertHooks = append(directoryParentBeforeUpsertHooks, directoryParentHook)
+	case boil.AfterUpsertHook:
+		directoryParentAfterUpsertHooks = append(directoryParentAfterUpsertHooks, directoryParentHook)
+	}
+}
+
+// One returns a single directoryParent record from the query.
+func (q directoryParentQuery) One(ctx context.Context, exec boil.ContextExecutor) (*DirectoryParent, error) {
+	o := &DirectoryParent{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to execute a one query for directory_parents")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all DirectoryParent records from the query.
+func (q directoryParentQuery) All(ctx context.Context, exec boil.ContextExecutor) (DirectoryParentSlice, error) {
+	var o []*DirectoryParent
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to DirectoryParent slice")
+	}
+
+	if len(directoryParentAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all DirectoryParent records in the query.
+func (q directoryParentQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count directory_parents rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q directoryParentQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if directory_parents exists")
+	}
+
+	return count > 0, nil
+}
+
+// Directory pointed to by the foreign key.
+func (o *DirectoryParent) Directory(mods ...qm.QueryMod) trackedDirectoryQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.DirectoryID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return TrackedDirectories(queryMods...)
+}
+
+// LoadDirectory allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (directoryParentL) LoadDirectory(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDirectoryParent interface{}, mods queries.Applicator) error {
+	var slice []*DirectoryParent
+	var object *DirectoryParent
+
+	if singular {
+		var ok bool
+		object, ok = maybeDirectoryParent.(*DirectoryParent)
+		if !ok {
+			object = new(DirectoryParent)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDirectoryParent)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDirectoryParent))
+			}
+		}
+	} else {
+		s, ok := maybeDirectoryParent.(*[]*DirectoryParent)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDirectoryParent)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDirectoryParent))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &directoryParentR{}
+		}
+		args = append(args, object.DirectoryID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &directoryParentR{}
+			}
+
+			for _, a := range args {
+				if a == obj.DirectoryID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.DirectoryID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`tracked_directories`),
+		qm.WhereIn(`tracked_directories.id in ?`, args...),
+		qmhelper.WhereIsNull(`tracked_directories.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load TrackedDirectory")
+	}
+
+	var resultSlice []*TrackedDirectory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice TrackedDirectory")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tracked_directories")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tracked_directories")
+	}
+
+	if len(trackedDirectoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Directory = foreign
+		if foreign.R == nil {
+			foreign.R = &trackedDirectoryR{}
+		}
+		foreign.R.DirectoryDirectoryParent = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.DirectoryID == foreign.ID {
+				local.R.Directory = foreign
+				if foreign.R == nil {
+					foreign.R = &trackedDirectoryR{}
+				}
+				foreign.R.DirectoryDirectoryParent = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetDirectory of the directoryParent to the related item.
+// Sets o.R.Directory to related.
+// Adds o to related.R.DirectoryDirectoryParent.
+func (o *DirectoryParent) SetDirectory(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TrackedDirectory) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"directory_parents\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"directory_id"}),
+		strmangle.WhereClause("\"", "\"", 2, directoryParentPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.DirectoryID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.DirectoryID = related.ID
+	if o.R == nil {
+		o.R = &directoryParentR{
+			Directory: related,
+		}
+	} else {
+		o.R.Directory = related
+	}
+
+	if related.R == nil {
+		related.R = &trackedDirectoryR{
+			DirectoryDirectoryParent: o,
+		}
+	} else {
+		related.R.DirectoryDirectoryParent = o
+	}
+
+	return nil
+}
+
+// DirectoryParents retrieves all the records using an executor.
+func DirectoryParents(mods ...qm.QueryMod) directoryParentQuery {
+	mods = append(mods, qm.From("\"directory_parents\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"directory_parents\".*"})
+	}
+
+	return directoryParentQuery{q}
+}
+
+// FindDirectoryParent retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDirectoryParent(ctx context.Context, exec boil.ContextExecutor, directoryID string, selectCols ...string) (*DirectoryParent, error) {
+	directoryParentObj := &DirectoryParent{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"directory_parents\" where \"directory_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, directoryID)
+
+	err := q.Bind(ctx, exec, directoryParentObj)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: unable to select from directory_parents")
+	}
+
+	if err = directoryParentObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return directoryParentObj, err
+	}
+
+	return directoryParentObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *DirectoryParent) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no directory_parents provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(directoryParentColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	directoryParentInsertCacheMut.RLock()
+	cache, cached := directoryParentInsertCache[key]
+	directoryParentInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			directoryParentAllColumns,
+			directoryParentColumnsWithDefault,
+			directoryParentColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(directoryParentType, directoryParentMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(directoryParentType, directoryParentMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"directory_parents\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"directory_parents\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into directory_parents")
+	}
+
+	if !cached {
+		directoryParentInsertCacheMut.Lock()
+		directoryParentInsertCache[key] = cache
+		directoryParentInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the DirectoryParent.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *DirectoryParent) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	directoryParentUpdateCacheMut.RLock()
+	cache, cached := directoryParentUpdateCache[key]
+	directoryParentUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			directoryParentAllColumns,
+			directoryParentPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update directory_parents, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"directory_parents\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, directoryParentPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(directoryParentType, directoryParentMapping, append(wl, directoryParentPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update directory_parents row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for directory_parents")
+	}
+
+	if !cached {
+		directoryParentUpdateCacheMut.Lock()
+		directoryParentUpdateCache[key] = cache
+		directoryParentUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q directoryParentQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for directory_parents")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for directory_parents")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DirectoryParentSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), directoryParentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"directory_parents\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, directoryParentPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in directoryParent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all directoryParent")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single DirectoryParent record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *DirectoryParent) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no DirectoryParent provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), directoryParentPrimaryKeyMapping)
+	sql := "DELETE FROM \"directory_parents\" WHERE \"directory_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from directory_parents")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for directory_parents")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q directoryParentQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no directoryParentQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from directory_parents")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for directory_parents")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DirectoryParentSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(directoryParentBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), directoryParentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"directory_parents\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, directoryParentPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from directoryParent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for directory_parents")
+	}
+
+	if len(directoryParentAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *DirectoryParent) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDirectoryParent(ctx, exec, o.DirectoryID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DirectoryParentSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DirectoryParentSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), directoryParentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"directory_parents\".* FROM \"directory_parents\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, directoryParentPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in DirectoryParentSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DirectoryParentExists checks if the DirectoryParent row exists.
+func DirectoryParentExists(ctx context.Context, exec boil.ContextExecutor, directoryID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"directory_parents\" where \"directory_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, directoryID)
+	}
+	row := exec.QueryRowContext(ctx, sql, directoryID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if directory_parents exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the DirectoryParent row exists.
+func (o *DirectoryParent) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return DirectoryParentExists(ctx, exec, o.DirectoryID)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *DirectoryParent) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no directory_parents provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(directoryParentColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	directoryParentUpsertCacheMut.RLock()
+	cache, cached := directoryParentUpsertCache[key]
+	directoryParentUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			directoryParentAllColumns,
+			directoryParentColumnsWithDefault,
+			directoryParentColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			directoryParentAllColumns,
+			directoryParentPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert directory_parents, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(directoryParentPrimaryKeyColumns))
+			copy(conflict, directoryParentPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryCockroachDB(dialect, "\"directory_parents\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(directoryParentType, directoryParentMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(directoryParentType, directoryParentMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		_, _ = fmt.Fprintln(boil.DebugWriter, cache.query)
+		_, _ = fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // CockcorachDB doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert directory_parents")
+	}
+
+	if !cached {
+		directoryParentUpsertCacheMut.Lock()
+		directoryParentUpsertCache[key] = cache
+		directoryParentUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -23,10 +24,11 @@ import (
 
 // EffectivePermission is an object representing the database table.
 type EffectivePermission struct {
-	SubjectID string `boil:"subject_id" json:"subject_id" toml:"subject_id" yaml:"subject_id"`
-	Target    string `boil:"target" json:"target" toml:"target" yaml:"target"`
-	Scope     string `boil:"scope" json:"scope" toml:"scope" yaml:"scope"`
-	FromRole  string `boil:"from_role" json:"from_role" toml:"from_role" yaml:"from_role"`
+	SubjectID string    `boil:"subject_id" json:"subject_id" toml:"subject_id" yaml:"subject_id"`
+	Target    string    `boil:"target" json:"target" toml:"target" yaml:"target"`
+	Scope     string    `boil:"scope" json:"scope" toml:"scope" yaml:"scope"`
+	FromRole  string    `boil:"from_role" json:"from_role" toml:"from_role" yaml:"from_role"`
+	ExpiresAt null.Time `boil:"expires_at" json:"expires_at,omitempty" toml:"expires_at" yaml:"expires_at,omitempty"`
 
 	R *effectivePermissionR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L effectivePermissionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -37,11 +39,13 @@ var EffectivePermissionColumns = struct {
 	Target    string
 	Scope     string
 	FromRole  string
+	ExpiresAt string
 }{
 	SubjectID: "subject_id",
 	Target:    "target",
 	Scope:     "scope",
 	FromRole:  "from_role",
+	ExpiresAt: "expires_at",
 }
 
 var EffectivePermissionTableColumns = struct {
@@ -49,48 +53,53 @@ var EffectivePermissionTableColumns = struct {
 	Target    string
 	Scope     string
 	FromRole  string
+	ExpiresAt string
 }{
 	SubjectID: "effective_permissions.subject_id",
 	Target:    "effective_permissions.target",
 	Scope:     "effective_permissions.scope",
 	FromRole:  "effective_permissions.from_role",
+	ExpiresAt: "effective_permissions.expires_at",
 }
 
 // Generated where
 
-type whereHelperstring struct{ field string }
+type whereHelpernull_Time struct{ field string }
 
-func (w whereHelperstring) EQ(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
 }
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
 }
+func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
 var EffectivePermissionWhere = struct {
 	SubjectID whereHelperstring
 	Target    whereHelperstring
 	Scope     whereHelperstring
 	FromRole  whereHelperstring
+	ExpiresAt whereHelpernull_Time
 }{
 	SubjectID: whereHelperstring{field: "\"effective_permissions\".\"subject_id\""},
 	Target:    whereHelperstring{field: "\"effective_permissions\".\"target\""},
 	Scope:     whereHelperstring{field: "\"effective_permissions\".\"scope\""},
 	FromRole:  whereHelperstring{field: "\"effective_permissions\".\"from_role\""},
+	ExpiresAt: whereHelpernull_Time{field: "\"effective_permissions\".\"expires_at\""},
 }
 
 // EffectivePermissionRels is where relationship names are stored.
@@ -151,9 +160,9 @@ func (r *effectivePermissionR) GetFromRoleRole() *Role {
 type effectivePermissionL struct{}
 
 var (
-	effectivePermissionAllColumns            = []string{"subject_id", "target", "scope", "from_role"}
+	effectivePermissionAllColumns            = []string{"subject_id", "target", "scope", "from_role", "expires_at"}
 	effectivePermissionColumnsWithoutDefault = []string{"subject_id", "target", "scope", "from_role"}
-	effectivePermissionColumnsWithDefault    = []string{}
+	effectivePermissionColumnsWithDefault    = []string{"expires_at"}
 	effectivePermissionPrimaryKeyColumns     = []string{"subject_id", "target", "scope"}
 	effectivePermissionGeneratedColumns      = []string{}
 )
@@ -0,0 +1,1286 @@
+// Code generated by SQLBoiler 4.14.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// GroupMember is an object representing the database table.
+type GroupMember struct {
+	GroupID   string    `boil:"group_id" json:"group_id" toml:"group_id" yaml:"group_id"`
+	MemberID  string    `boil:"member_id" json:"member_id" toml:"member_id" yaml:"member_id"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *groupMemberR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L groupMemberL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var GroupMemberColumns = struct {
+	GroupID   string
+	MemberID  string
+	CreatedAt string
+}{
+	GroupID:   "group_id",
+	MemberID:  "member_id",
+	CreatedAt: "created_at",
+}
+
+var GroupMemberTableColumns = struct {
+	GroupID   string
+	MemberID  string
+	CreatedAt string
+}{
+	GroupID:   "group_members.group_id",
+	MemberID:  "group_members.member_id",
+	CreatedAt: "group_members.created_at",
+}
+
+// Generated where
+
+var GroupMemberWhere = struct {
+	GroupID   whereHelperstring
+	MemberID  whereHelperstring
+	CreatedAt whereHelpertime_Time
+}{
+	GroupID:   whereHelperstring{field: "\"group_members\".\"group_id\""},
+	MemberID:  whereHelperstring{field: "\"group_members\".\"member_id\""},
+	CreatedAt: whereHelpertime_Time{field: "\"group_members\".\"created_at\""},
+}
+
+// GroupMemberRels is where relationship names are stored.
+var GroupMemberRels = struct {
+	Member string
+	Group  string
+}{
+	Member: "Member",
+	Group:  "Group",
+}
+
+// groupMemberR is where relationships are stored.
+type groupMemberR struct {
+	Member *TrackedSubject `boil:"Member" json:"Member" toml:"Member" yaml:"Member"`
+	Group  *SubjectGroup   `boil:"Group" json:"Group" toml:"Group" yaml:"Group"`
+}
+
+// NewStruct creates a new relationship struct
+func (*groupMemberR) NewStruct() *groupMemberR {
+	return &groupMemberR{}
+}
+
+func (r *groupMemberR) GetMember() *TrackedSubject {
+	if r == nil {
+		return nil
+	}
+	return r.Member
+}
+
+func (r *groupMemberR) GetGroup() *SubjectGroup {
+	if r == nil {
+		return nil
+	}
+	return r.Group
+}
+
+// groupMemberL is where Load methods for each relationship are stored.
+type groupMemberL struct{}
+
+var (
+	groupMemberAllColumns            = []string{"group_id", "member_id", "created_at"}
+	groupMemberColumnsWithoutDefault = []string{"group_id", "member_id"}
+	groupMemberColumnsWithDefault    = []string{"created_at"}
+	groupMemberPrimaryKeyColumns     = []string{"group_id", "member_id"}
+	groupMemberGeneratedColumns      = []string{}
+)
+
+type (
+	// GroupMemberSlice is an alias for a slice of pointers to GroupMember.
+	// This should almost always be used instead of []GroupMember.
+	GroupMemberSlice []*GroupMember
+	// GroupMemberHook is the signature for custom GroupMember hook methods
+	GroupMemberHook func(context.Context, boil.ContextExecutor, *GroupMember) error
+
+	groupMemberQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	groupMemberType                 = reflect.TypeOf(&GroupMember{})
+	groupMemberMapping              = queries.MakeStructMapping(groupMemberType)
+	groupMemberPrimaryKeyMapping, _ = queries.BindMapping(groupMemberType, groupMemberMapping, groupMemberPrimaryKeyColumns)
+	groupMemberInsertCacheMut       sync.RWMutex
+	groupMemberInsertCache          = make(map[string]insertCache)
+	groupMemberUpdateCacheMut       sync.RWMutex
+	groupMemberUpdateCache          = make(map[string]updateCache)
+	groupMemberUpsertCacheMut       sync.RWMutex
+	groupMemberUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var groupMemberAfterSelectHooks []GroupMemberHook
+
+var groupMemberBeforeInsertHooks []GroupMemberHook
+var groupMemberAfterInsertHooks []GroupMemberHook
+
+var groupMemberBeforeUpdateHooks []GroupMemberHook
+var groupMemberAfterUpdateHooks []GroupMemberHook
+
+var groupMemberBeforeDeleteHooks []GroupMemberHook
+var groupMemberAfterDeleteHooks []GroupMemberHook
+
+var groupMemberBeforeUpsertHooks []GroupMemberHook
+var groupMemberAfterUpsertHooks []GroupMemberHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *GroupMember) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range groupMemberAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *GroupMember) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range groupMemberBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *GroupMember) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range groupMemberAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *GroupMember) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range groupMemberBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *GroupMember) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range groupMemberAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *GroupMember) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range groupMemberBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *GroupMember) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range groupMemberAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *GroupMember) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range groupMemberBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *GroupMember) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range groupMemberAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddGroupMemberHook registers your hook function for all future operations.
+func AddGroupMemberHook(hookPoint boil.HookPoint, groupMemberHook GroupMemberHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		groupMemberAfterSelectHooks = append(groupMemberAfterSelectHooks, groupMemberHook)
+	case boil.BeforeInsertHook:
+		groupMemberBeforeInsertHooks = append(groupMemberBeforeInsertHooks, groupMemberHook)
+	case boil.AfterInsertHook:
+		groupMemberAfterInsertHooks = append(groupMemberAfterInsertHooks, groupMemberHook)
+	case boil.BeforeUpdateHook:
+		groupMemberBeforeUpdateHooks = append(groupMemberBeforeUpdateHooks, groupMemberHook)
+	case boil.AfterUpdateHook:
+		groupMemberAfterUpdateHooks = append(groupMemberAfterUpdateHooks, groupMemberHook)
+	case boil.BeforeDeleteHook:
+		groupMemberBeforeDeleteHooks = append(groupMemberBeforeDeleteHooks, groupMemberHook)
+	case boil.AfterDeleteHook:
+		groupMemberAfterDeleteHooks = append(groupMemberAfterDeleteHooks, groupMemberHook)
+	case boil.BeforeUpsertHook:
+		groupMemberBeforeUpsertHooks = append(groupMemberBeforeUpsertHooks, groupMemberHook)
+	case boil.AfterUpsertHook:
+		groupMemberAfterUpsertHooks = append(groupMemberAfterUpsertHooks, groupMemberHook)
+	}
+}
+
+// One returns a single groupMember record from the query.
+func (q groupMemberQuery) One(ctx context.Context, exec boil.ContextExecutor) (*GroupMember, error) {
+	o := &GroupMember{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to execute a one query for group_members")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all GroupMember records from the query.
+func (q groupMemberQuery) All(ctx context.Context, exec boil.ContextExecutor) (GroupMemberSlice, error) {
+	var o []*GroupMember
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to GroupMember slice")
+	}
+
+	if len(groupMemberAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all GroupMember records in the query.
+func (q groupMemberQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count group_members rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q groupMemberQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if group_members exists")
+	}
+
+	return count > 0, nil
+}
+
+// Member pointed to by the foreign key.
+func (o *GroupMember) Member(mods ...qm.QueryMod) trackedSubjectQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"subject_id\" = ?", o.MemberID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return TrackedSubjects(queryMods...)
+}
+
+// Group pointed to by the foreign key.
+func (o *GroupMember) Group(mods ...qm.QueryMod) subjectGroupQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"subject_id\" = ?", o.GroupID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return SubjectGroups(queryMods...)
+}
+
+// LoadMember allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (groupMemberL) LoadMember(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroupMember interface{}, mods queries.Applicator) error {
+	var slice []*GroupMember
+	var object *GroupMember
+
+	if singular {
+		var ok bool
+		object, ok = maybeGroupMember.(*GroupMember)
+		if !ok {
+			object = new(GroupMember)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeGroupMember)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeGroupMember))
+			}
+		}
+	} else {
+		s, ok := maybeGroupMember.(*[]*GroupMember)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeGroupMember)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeGroupMember))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &groupMemberR{}
+		}
+		args = append(args, object.MemberID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &groupMemberR{}
+			}
+
+			for _, a := range args {
+				if a == obj.MemberID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.MemberID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`tracked_subjects`),
+		qm.WhereIn(`tracked_subjects.subject_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load TrackedSubject")
+	}
+
+	var resultSlice []*TrackedSubject
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice TrackedSubject")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tracked_subjects")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tracked_subjects")
+	}
+
+	if len(trackedSubjectAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Member = foreign
+		if foreign.R == nil {
+			foreign.R = &trackedSubjectR{}
+		}
+		foreign.R.MemberGroupMembers = append(foreign.R.MemberGroupMembers, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.MemberID == foreign.SubjectID {
+				local.R.Member = foreign
+				if foreign.R == nil {
+					foreign.R = &trackedSubjectR{}
+				}
+				foreign.R.MemberGroupMembers = append(foreign.R.MemberGroupMembers, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadGroup allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (groupMemberL) LoadGroup(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGroupMember interface{}, mods queries.Applicator) error {
+	var slice []*GroupMember
+	var object *GroupMember
+
+	if singular {
+		var ok bool
+		object, ok = maybeGroupMember.(*GroupMember)
+		if !ok {
+			object = new(GroupMember)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeGroupMember)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeGroupMember))
+			}
+		}
+	} else {
+		s, ok := maybeGroupMember.(*[]*GroupMember)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeGroupMember)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeGroupMember))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &groupMemberR{}
+		}
+		args = append(args, object.GroupID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &groupMemberR{}
+			}
+
+			for _, a := range args {
+				if a == obj.GroupID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.GroupID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`subject_groups`),
+		qm.WhereIn(`subject_groups.subject_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load SubjectGroup")
+	}
+
+	var resultSlice []*SubjectGroup
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice SubjectGroup")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for subject_groups")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for subject_groups")
+	}
+
+	if len(subjectGroupAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Group = foreign
+		if foreign.R == nil {
+			foreign.R = &subjectGroupR{}
+		}
+		foreign.R.GroupGroupMembers = append(foreign.R.GroupGroupMembers, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.GroupID == foreign.SubjectID {
+				local.R.Group = foreign
+				if foreign.R == nil {
+					foreign.R = &subjectGroupR{}
+				}
+				foreign.R.GroupGroupMembers = append(foreign.R.GroupGroupMembers, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetMember of the groupMember to the related item.
+// Sets o.R.Member to related.
+// Adds o to related.R.MemberGroupMembers.
+func (o *GroupMember) SetMember(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TrackedSubject) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"group_members\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"member_id"}),
+		strmangle.WhereClause("\"", "\"", 2, groupMemberPrimaryKeyColumns),
+	)
+	values := []interface{}{related.SubjectID, o.GroupID, o.MemberID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.MemberID = related.SubjectID
+	if o.R == nil {
+		o.R = &groupMemberR{
+			Member: related,
+		}
+	} else {
+		o.R.Member = related
+	}
+
+	if related.R == nil {
+		related.R = &trackedSubjectR{
+			MemberGroupMembers: GroupMemberSlice{o},
+		}
+	} else {
+		related.R.MemberGroupMembers = append(related.R.MemberGroupMembers, o)
+	}
+
+	return nil
+}
+
+// SetGroup of the groupMember to the related item.
+// Sets o.R.Group to related.
+// Adds o to related.R.GroupGroupMembers.
+func (o *GroupMember) SetGroup(ctx context.Context, exec boil.ContextExecutor, insert bool, related *SubjectGroup) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"group_members\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"group_id"}),
+		strmangle.WhereClause("\"", "\"", 2, groupMemberPrimaryKeyColumns),
+	)
+	values := []interface{}{related.SubjectID, o.GroupID, o.MemberID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.GroupID = related.SubjectID
+	if o.R == nil {
+		o.R = &groupMemberR{
+			Group: related,
+		}
+	} else {
+		o.R.Group = related
+	}
+
+	if related.R == nil {
+		related.R = &subjectGroupR{
+			GroupGroupMembers: GroupMemberSlice{o},
+		}
+	} else {
+		related.R.GroupGroupMembers = append(related.R.GroupGroupMembers, o)
+	}
+
+	return nil
+}
+
+// GroupMembers retrieves all the records using an executor.
+func GroupMembers(mods ...qm.QueryMod) groupMemberQuery {
+	mods = append(mods, qm.From("\"group_members\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"group_members\".*"})
+	}
+
+	return groupMemberQuery{q}
+}
+
+// FindGroupMember retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindGroupMember(ctx context.Context, exec boil.ContextExecutor, groupID string, memberID string, selectCols ...string) (*GroupMember, error) {
+	groupMemberObj := &GroupMember{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"group_members\" where \"group_id\"=$1 AND \"member_id\"=$2", sel,
+	)
+
+	q := queries.Raw(query, groupID, memberID)
+
+	err := q.Bind(ctx, exec, groupMemberObj)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: unable to select from group_members")
+	}
+
+	if err = groupMemberObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return groupMemberObj, err
+	}
+
+	return groupMemberObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *GroupMember) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no group_members provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(groupMemberColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	groupMemberInsertCacheMut.RLock()
+	cache, cached := groupMemberInsertCache[key]
+	groupMemberInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			groupMemberAllColumns,
+			groupMemberColumnsWithDefault,
+			groupMemberColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(groupMemberType, groupMemberMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(groupMemberType, groupMemberMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"group_members\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"group_members\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into group_members")
+	}
+
+	if !cached {
+		groupMemberInsertCacheMut.Lock()
+		groupMemberInsertCache[key] = cache
+		groupMemberInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the GroupMember.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *GroupMember) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	groupMemberUpdateCacheMut.RLock()
+	cache, cached := groupMemberUpdateCache[key]
+	groupMemberUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			groupMemberAllColumns,
+			groupMemberPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update group_members, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"group_members\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, groupMemberPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(groupMemberType, groupMemberMapping, append(wl, groupMemberPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update group_members row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for group_members")
+	}
+
+	if !cached {
+		groupMemberUpdateCacheMut.Lock()
+		groupMemberUpdateCache[key] = cache
+		groupMemberUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q groupMemberQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for group_members")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for group_members")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o GroupMemberSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), groupMemberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"group_members\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, groupMemberPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in groupMember slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all groupMember")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single GroupMember record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *GroupMember) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no GroupMember provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), groupMemberPrimaryKeyMapping)
+	sql := "DELETE FROM \"group_members\" WHERE \"group_id\"=$1 AND \"member_id\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from group_members")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for group_members")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q groupMemberQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no groupMemberQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from group_members")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for group_members")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o GroupMemberSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(groupMemberBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), groupMemberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"group_members\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, groupMemberPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from groupMember slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for group_members")
+	}
+
+	if len(groupMemberAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *GroupMember) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindGroupMember(ctx, exec, o.GroupID, o.MemberID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *GroupMemberSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := GroupMemberSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), groupMemberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"group_members\".* FROM \"group_members\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, groupMemberPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in GroupMemberSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// GroupMemberExists checks if the GroupMember row exists.
+func GroupMemberExists(ctx context.Context, exec boil.ContextExecutor, groupID string, memberID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"group_members\" where \"group_id\"=$1 AND \"member_id\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, groupID, memberID)
+	}
+	row := exec.QueryRowContext(ctx, sql, groupID, memberID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if group_members exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the GroupMember row exists.
+func (o *GroupMember) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return GroupMemberExists(ctx, exec, o.GroupID, o.MemberID)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *GroupMember) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no group_members provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(groupMemberColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	groupMemberUpsertCacheMut.RLock()
+	cache, cached := groupMemberUpsertCache[key]
+	groupMemberUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			groupMemberAllColumns,
+			groupMemberColumnsWithDefault,
+			groupMemberColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			groupMemberAllColumns,
+			groupMemberPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert group_members, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(groupMemberPrimaryKeyColumns))
+			copy(conflict, groupMemberPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryCockroachDB(dialect, "\"group_members\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(groupMemberType, groupMemberMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(groupMemberType, groupMemberMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		_, _ = fmt.Fprintln(boil.DebugWriter, cache.query)
+		_, _ = fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // CockcorachDB doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert group_members")
+	}
+
+	if !cached {
+		groupMemberUpsertCacheMut.Lock()
+		groupMemberUpsertCache[key] = cache
+		groupMemberUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
@@ -58,27 +58,6 @@ var PermissionTableColumns = struct {
 
 // Generated where
 
-type whereHelpertime_Time struct{ field string }
-
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
-}
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
 var PermissionWhere = struct {
 	Target      whereHelperstring
 	Description whereHelperstring
@@ -610,7 +589,7 @@ func (permissionL) LoadRoles(ctx context.Context, e boil.ContextExecutor, singul
 	}
 
 	query := NewQuery(
-		qm.Select("\"roles\".\"id\", \"roles\".\"name\", \"roles\".\"description\", \"roles\".\"created_at\", \"roles\".\"updated_at\", \"a\".\"target\""),
+		qm.Select("\"roles\".\"id\", \"roles\".\"name\", \"roles\".\"description\", \"roles\".\"created_at\", \"roles\".\"updated_at\", \"roles\".\"directory_id\", \"roles\".\"version\", \"a\".\"target\""),
 		qm.From("\"roles\""),
 		qm.InnerJoin("\"role_permissions\" as \"a\" on \"roles\".\"id\" = \"a\".\"role_id\""),
 		qm.WhereIn("\"a\".\"target\" in ?", args...),
@@ -631,7 +610,7 @@ func (permissionL) LoadRoles(ctx context.Context, e boil.ContextExecutor, singul
 		one := new(Role)
 		var localJoinCol string
 
-		err = results.Scan(&one.ID, &one.Name, &one.Description, &one.CreatedAt, &one.UpdatedAt, &localJoinCol)
+		err = results.Scan(&one.ID, &one.Name, &one.Description, &one.CreatedAt, &one.UpdatedAt, &one.DirectoryID, &one.Version, &localJoinCol)
 		if err != nil {
 			return errors.Wrap(err, "failed to scan eager loaded results for roles")
 		}
@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -29,6 +30,8 @@ type RoleAssignment struct {
 	Scope     string    `boil:"scope" json:"scope" toml:"scope" yaml:"scope"`
 	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	NotBefore null.Time `boil:"not_before" json:"not_before,omitempty" toml:"not_before" yaml:"not_before,omitempty"`
+	ExpiresAt null.Time `boil:"expires_at" json:"expires_at,omitempty" toml:"expires_at" yaml:"expires_at,omitempty"`
 
 	R *roleAssignmentR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L roleAssignmentL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -41,6 +44,8 @@ var RoleAssignmentColumns = struct {
 	Scope     string
 	CreatedAt string
 	UpdatedAt string
+	NotBefore string
+	ExpiresAt string
 }{
 	ID:        "id",
 	RoleID:    "role_id",
@@ -48,6 +53,8 @@ var RoleAssignmentColumns = struct {
 	Scope:     "scope",
 	CreatedAt: "created_at",
 	UpdatedAt: "updated_at",
+	NotBefore: "not_before",
+	ExpiresAt: "expires_at",
 }
 
 var RoleAssignmentTableColumns = struct {
@@ -57,6 +64,8 @@ var RoleAssignmentTableColumns = struct {
 	Scope     string
 	CreatedAt string
 	UpdatedAt string
+	NotBefore string
+	ExpiresAt string
 }{
 	ID:        "role_assignments.id",
 	RoleID:    "role_assignments.role_id",
@@ -64,6 +73,8 @@ var RoleAssignmentTableColumns = struct {
 	Scope:     "role_assignments.scope",
 	CreatedAt: "role_assignments.created_at",
 	UpdatedAt: "role_assignments.updated_at",
+	NotBefore: "role_assignments.not_before",
+	ExpiresAt: "role_assignments.expires_at",
 }
 
 // Generated where
@@ -75,6 +86,8 @@ var RoleAssignmentWhere = struct {
 	Scope     whereHelperstring
 	CreatedAt whereHelpertime_Time
 	UpdatedAt whereHelpertime_Time
+	NotBefore whereHelpernull_Time
+	ExpiresAt whereHelpernull_Time
 }{
 	ID:        whereHelperstring{field: "\"role_assignments\".\"id\""},
 	RoleID:    whereHelperstring{field: "\"role_assignments\".\"role_id\""},
@@ -82,6 +95,8 @@ var RoleAssignmentWhere = struct {
 	Scope:     whereHelperstring{field: "\"role_assignments\".\"scope\""},
 	CreatedAt: whereHelpertime_Time{field: "\"role_assignments\".\"created_at\""},
 	UpdatedAt: whereHelpertime_Time{field: "\"role_assignments\".\"updated_at\""},
+	NotBefore: whereHelpernull_Time{field: "\"role_assignments\".\"not_before\""},
+	ExpiresAt: whereHelpernull_Time{field: "\"role_assignments\".\"expires_at\""},
 }
 
 // RoleAssignmentRels is where relationship names are stored.
@@ -132,9 +147,9 @@ func (r *roleAssignmentR) GetRole() *Role {
 type roleAssignmentL struct{}
 
 var (
-	roleAssignmentAllColumns            = []string{"id", "role_id", "subject_id", "scope", "created_at", "updated_at"}
+	roleAssignmentAllColumns            = []string{"id", "role_id", "subject_id", "scope", "created_at", "updated_at", "not_before", "expires_at"}
 	roleAssignmentColumnsWithoutDefault = []string{"role_id", "subject_id", "scope"}
-	roleAssignmentColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	roleAssignmentColumnsWithDefault    = []string{"id", "created_at", "updated_at", "not_before", "expires_at"}
 	roleAssignmentPrimaryKeyColumns     = []string{"id"}
 	roleAssignmentGeneratedColumns      = []string{}
 )
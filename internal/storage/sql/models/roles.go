@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -23,11 +24,13 @@ import (
 
 // Role is an object representing the database table.
 type Role struct {
-	ID          string    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Name        string    `boil:"name" json:"name" toml:"name" yaml:"name"`
-	Description string    `boil:"description" json:"description" toml:"description" yaml:"description"`
-	CreatedAt   time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt   time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ID          string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name        string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Description string      `boil:"description" json:"description" toml:"description" yaml:"description"`
+	CreatedAt   time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt   time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DirectoryID null.String `boil:"directory_id" json:"directory_id,omitempty" toml:"directory_id" yaml:"directory_id,omitempty"`
+	Version     int64       `boil:"version" json:"version" toml:"version" yaml:"version"`
 
 	R *roleR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L roleL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -39,12 +42,16 @@ var RoleColumns = struct {
 	Description string
 	CreatedAt   string
 	UpdatedAt   string
+	DirectoryID string
+	Version     string
 }{
 	ID:          "id",
 	Name:        "name",
 	Description: "description",
 	CreatedAt:   "created_at",
 	UpdatedAt:   "updated_at",
+	DirectoryID: "directory_id",
+	Version:     "version",
 }
 
 var RoleTableColumns = struct {
@@ -53,12 +60,16 @@ var RoleTableColumns = struct {
 	Description string
 	CreatedAt   string
 	UpdatedAt   string
+	DirectoryID string
+	Version     string
 }{
 	ID:          "roles.id",
 	Name:        "roles.name",
 	Description: "roles.description",
 	CreatedAt:   "roles.created_at",
 	UpdatedAt:   "roles.updated_at",
+	DirectoryID: "roles.directory_id",
+	Version:     "roles.version",
 }
 
 // Generated where
@@ -69,29 +80,45 @@ var RoleWhere = struct {
 	Description whereHelperstring
 	CreatedAt   whereHelpertime_Time
 	UpdatedAt   whereHelpertime_Time
+	DirectoryID whereHelpernull_String
+	Version     whereHelperint64
 }{
 	ID:          whereHelperstring{field: "\"roles\".\"id\""},
 	Name:        whereHelperstring{field: "\"roles\".\"name\""},
 	Description: whereHelperstring{field: "\"roles\".\"description\""},
 	CreatedAt:   whereHelpertime_Time{field: "\"roles\".\"created_at\""},
 	UpdatedAt:   whereHelpertime_Time{field: "\"roles\".\"updated_at\""},
+	DirectoryID: whereHelpernull_String{field: "\"roles\".\"directory_id\""},
+	Version:     whereHelperint64{field: "\"roles\".\"version\""},
 }
 
 // RoleRels is where relationship names are stored.
 var RoleRels = struct {
+	Directory                    string
+	DenyRules                    string
 	FromRoleEffectivePermissions string
 	RoleAssignments              string
+	IncludedRoleRoles            string
+	Roles                        string
 	TargetPermissions            string
 }{
+	Directory:                    "Directory",
+	DenyRules:                    "DenyRules",
 	FromRoleEffectivePermissions: "FromRoleEffectivePermissions",
 	RoleAssignments:              "RoleAssignments",
+	IncludedRoleRoles:            "IncludedRoleRoles",
+	Roles:                        "Roles",
 	TargetPermissions:            "TargetPermissions",
 }
 
 // roleR is where relationships are stored.
 type roleR struct {
+	Directory                    *TrackedDirectory        `boil:"Directory" json:"Directory" toml:"Directory" yaml:"Directory"`
+	DenyRules                    DenyRuleSlice            `boil:"DenyRules" json:"DenyRules" toml:"DenyRules" yaml:"DenyRules"`
 	FromRoleEffectivePermissions EffectivePermissionSlice `boil:"FromRoleEffectivePermissions" json:"FromRoleEffectivePermissions" toml:"FromRoleEffectivePermissions" yaml:"FromRoleEffectivePermissions"`
 	RoleAssignments              RoleAssignmentSlice      `boil:"RoleAssignments" json:"RoleAssignments" toml:"RoleAssignments" yaml:"RoleAssignments"`
+	IncludedRoleRoles            RoleSlice                `boil:"IncludedRoleRoles" json:"IncludedRoleRoles" toml:"IncludedRoleRoles" yaml:"IncludedRoleRoles"`
+	Roles                        RoleSlice                `boil:"Roles" json:"Roles" toml:"Roles" yaml:"Roles"`
 	TargetPermissions            PermissionSlice          `boil:"TargetPermissions" json:"TargetPermissions" toml:"TargetPermissions" yaml:"TargetPermissions"`
 }
 
@@ -100,6 +127,20 @@ func (*roleR) NewStruct() *roleR {
 	return &roleR{}
 }
 
+func (r *roleR) GetDirectory() *TrackedDirectory {
+	if r == nil {
+		return nil
+	}
+	return r.Directory
+}
+
+func (r *roleR) GetDenyRules() DenyRuleSlice {
+	if r == nil {
+		return nil
+	}
+	return r.DenyRules
+}
+
 func (r *roleR) GetFromRoleEffectivePermissions() EffectivePermissionSlice {
 	if r == nil {
 		return nil
@@ -114,6 +155,20 @@ func (r *roleR) GetRoleAssignments() RoleAssignmentSlice {
 	return r.RoleAssignments
 }
 
+func (r *roleR) GetIncludedRoleRoles() RoleSlice {
+	if r == nil {
+		return nil
+	}
+	return r.IncludedRoleRoles
+}
+
+func (r *roleR) GetRoles() RoleSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Roles
+}
+
 func (r *roleR) GetTargetPermissions() PermissionSlice {
 	if r == nil {
 		return nil
@@ -125,9 +180,9 @@ func (r *roleR) GetTargetPermissions() PermissionSlice {
 type roleL struct{}
 
 var (
-	roleAllColumns            = []string{"id", "name", "description", "created_at", "updated_at"}
+	roleAllColumns            = []string{"id", "name", "description", "created_at", "updated_at", "directory_id", "version"}
 	roleColumnsWithoutDefault = []string{"name"}
-	roleColumnsWithDefault    = []string{"id", "description", "created_at", "updated_at"}
+	roleColumnsWithDefault    = []string{"id", "description", "created_at", "updated_at", "directory_id", "version"}
 	rolePrimaryKeyColumns     = []string{"id"}
 	roleGeneratedColumns      = []string{}
 )
@@ -407,6 +462,31 @@ func (q roleQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool,
 	return count > 0, nil
 }
 
+// Directory pointed to by the foreign key.
+func (o *Role) Directory(mods ...qm.QueryMod) trackedDirectoryQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.DirectoryID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return TrackedDirectories(queryMods...)
+}
+
+// DenyRules retrieves all the deny_rule's DenyRules with an executor.
+func (o *Role) DenyRules(mods ...qm.QueryMod) denyRuleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"deny_rules\".\"role_id\"=?", o.ID),
+	)
+
+	return DenyRules(queryMods...)
+}
+
 // FromRoleEffectivePermissions retrieves all the effective_permission's EffectivePermissions with an executor via from_role column.
 func (o *Role) FromRoleEffectivePermissions(mods ...qm.QueryMod) effectivePermissionQuery {
 	var queryMods []qm.QueryMod
@@ -435,6 +515,36 @@ func (o *Role) RoleAssignments(mods ...qm.QueryMod) roleAssignmentQuery {
 	return RoleAssignments(queryMods...)
 }
 
+// IncludedRoleRoles retrieves all the role's Roles with an executor via id column.
+func (o *Role) IncludedRoleRoles(mods ...qm.QueryMod) roleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.InnerJoin("\"role_includes\" on \"roles\".\"id\" = \"role_includes\".\"included_role_id\""),
+		qm.Where("\"role_includes\".\"role_id\"=?", o.ID),
+	)
+
+	return Roles(queryMods...)
+}
+
+// Roles retrieves all the role's Roles with an executor.
+func (o *Role) Roles(mods ...qm.QueryMod) roleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.InnerJoin("\"role_includes\" on \"roles\".\"id\" = \"role_includes\".\"role_id\""),
+		qm.Where("\"role_includes\".\"included_role_id\"=?", o.ID),
+	)
+
+	return Roles(queryMods...)
+}
+
 // TargetPermissions retrieves all the permission's Permissions with an executor via target column.
 func (o *Role) TargetPermissions(mods ...qm.QueryMod) permissionQuery {
 	var queryMods []qm.QueryMod
@@ -450,9 +560,9 @@ func (o *Role) TargetPermissions(mods ...qm.QueryMod) permissionQuery {
 	return Permissions(queryMods...)
 }
 
-// LoadFromRoleEffectivePermissions allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (roleL) LoadFromRoleEffectivePermissions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRole interface{}, mods queries.Applicator) error {
+// LoadDirectory allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (roleL) LoadDirectory(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRole interface{}, mods queries.Applicator) error {
 	var slice []*Role
 	var object *Role
 
@@ -483,7 +593,10 @@ func (roleL) LoadFromRoleEffectivePermissions(ctx context.Context, e boil.Contex
 		if object.R == nil {
 			object.R = &roleR{}
 		}
-		args = append(args, object.ID)
+		if !queries.IsNil(object.DirectoryID) {
+			args = append(args, object.DirectoryID)
+		}
+
 	} else {
 	Outer:
 		for _, obj := range slice {
@@ -492,12 +605,15 @@ func (roleL) LoadFromRoleEffectivePermissions(ctx context.Context, e boil.Contex
 			}
 
 			for _, a := range args {
-				if a == obj.ID {
+				if queries.Equal(a, obj.DirectoryID) {
 					continue Outer
 				}
 			}
 
-			args = append(args, obj.ID)
+			if !queries.IsNil(obj.DirectoryID) {
+				args = append(args, obj.DirectoryID)
+			}
+
 		}
 	}
 
@@ -506,8 +622,9 @@ func (roleL) LoadFromRoleEffectivePermissions(ctx context.Context, e boil.Contex
 	}
 
 	query := NewQuery(
-		qm.From(`effective_permissions`),
-		qm.WhereIn(`effective_permissions.from_role in ?`, args...),
+		qm.From(`tracked_directories`),
+		qm.WhereIn(`tracked_directories.id in ?`, args...),
+		qmhelper.WhereIsNull(`tracked_directories.deleted_at`),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -515,47 +632,51 @@ func (roleL) LoadFromRoleEffectivePermissions(ctx context.Context, e boil.Contex
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load effective_permissions")
+		return errors.Wrap(err, "failed to eager load TrackedDirectory")
 	}
 
-	var resultSlice []*EffectivePermission
+	var resultSlice []*TrackedDirectory
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice effective_permissions")
+		return errors.Wrap(err, "failed to bind eager loaded slice TrackedDirectory")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on effective_permissions")
+		return errors.Wrap(err, "failed to close results of eager load for tracked_directories")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for effective_permissions")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tracked_directories")
 	}
 
-	if len(effectivePermissionAfterSelectHooks) != 0 {
+	if len(trackedDirectoryAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.FromRoleEffectivePermissions = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &effectivePermissionR{}
-			}
-			foreign.R.FromRoleRole = object
+		foreign := resultSlice[0]
+		object.R.Directory = foreign
+		if foreign.R == nil {
+			foreign.R = &trackedDirectoryR{}
 		}
+		foreign.R.DirectoryRoles = append(foreign.R.DirectoryRoles, object)
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.FromRole {
-				local.R.FromRoleEffectivePermissions = append(local.R.FromRoleEffectivePermissions, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.DirectoryID, foreign.ID) {
+				local.R.Directory = foreign
 				if foreign.R == nil {
-					foreign.R = &effectivePermissionR{}
+					foreign.R = &trackedDirectoryR{}
 				}
-				foreign.R.FromRoleRole = local
+				foreign.R.DirectoryRoles = append(foreign.R.DirectoryRoles, local)
 				break
 			}
 		}
@@ -564,9 +685,9 @@ func (roleL) LoadFromRoleEffectivePermissions(ctx context.Context, e boil.Contex
 	return nil
 }
 
-// LoadRoleAssignments allows an eager lookup of values, cached into the
+// LoadDenyRules allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (roleL) LoadRoleAssignments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRole interface{}, mods queries.Applicator) error {
+func (roleL) LoadDenyRules(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRole interface{}, mods queries.Applicator) error {
 	var slice []*Role
 	var object *Role
 
@@ -606,7 +727,7 @@ func (roleL) LoadRoleAssignments(ctx context.Context, e boil.ContextExecutor, si
 			}
 
 			for _, a := range args {
-				if a == obj.ID {
+				if queries.Equal(a, obj.ID) {
 					continue Outer
 				}
 			}
@@ -620,8 +741,8 @@ func (roleL) LoadRoleAssignments(ctx context.Context, e boil.ContextExecutor, si
 	}
 
 	query := NewQuery(
-		qm.From(`role_assignments`),
-		qm.WhereIn(`role_assignments.role_id in ?`, args...),
+		qm.From(`deny_rules`),
+		qm.WhereIn(`deny_rules.role_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -629,22 +750,22 @@ func (roleL) LoadRoleAssignments(ctx context.Context, e boil.ContextExecutor, si
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load role_assignments")
+		return errors.Wrap(err, "failed to eager load deny_rules")
 	}
 
-	var resultSlice []*RoleAssignment
+	var resultSlice []*DenyRule
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice role_assignments")
+		return errors.Wrap(err, "failed to bind eager loaded slice deny_rules")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on role_assignments")
+		return errors.Wrap(err, "failed to close results in eager load on deny_rules")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for role_assignments")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for deny_rules")
 	}
 
-	if len(roleAssignmentAfterSelectHooks) != 0 {
+	if len(denyRuleAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -652,10 +773,10 @@ func (roleL) LoadRoleAssignments(ctx context.Context, e boil.ContextExecutor, si
 		}
 	}
 	if singular {
-		object.R.RoleAssignments = resultSlice
+		object.R.DenyRules = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &roleAssignmentR{}
+				foreign.R = &denyRuleR{}
 			}
 			foreign.R.Role = object
 		}
@@ -664,10 +785,10 @@ func (roleL) LoadRoleAssignments(ctx context.Context, e boil.ContextExecutor, si
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.RoleID {
-				local.R.RoleAssignments = append(local.R.RoleAssignments, foreign)
+			if queries.Equal(local.ID, foreign.RoleID) {
+				local.R.DenyRules = append(local.R.DenyRules, foreign)
 				if foreign.R == nil {
-					foreign.R = &roleAssignmentR{}
+					foreign.R = &denyRuleR{}
 				}
 				foreign.R.Role = local
 				break
@@ -678,9 +799,9 @@ func (roleL) LoadRoleAssignments(ctx context.Context, e boil.ContextExecutor, si
 	return nil
 }
 
-// LoadTargetPermissions allows an eager lookup of values, cached into the
+// LoadFromRoleEffectivePermissions allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (roleL) LoadTargetPermissions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRole interface{}, mods queries.Applicator) error {
+func (roleL) LoadFromRoleEffectivePermissions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRole interface{}, mods queries.Applicator) error {
 	var slice []*Role
 	var object *Role
 
@@ -734,10 +855,8 @@ func (roleL) LoadTargetPermissions(ctx context.Context, e boil.ContextExecutor,
 	}
 
 	query := NewQuery(
-		qm.Select("\"permissions\".\"target\", \"permissions\".\"description\", \"permissions\".\"created_at\", \"permissions\".\"updated_at\", \"a\".\"role_id\""),
-		qm.From("\"permissions\""),
-		qm.InnerJoin("\"role_permissions\" as \"a\" on \"permissions\".\"target\" = \"a\".\"target\""),
-		qm.WhereIn("\"a\".\"role_id\" in ?", args...),
+		qm.From(`effective_permissions`),
+		qm.WhereIn(`effective_permissions.from_role in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -745,36 +864,22 @@ func (roleL) LoadTargetPermissions(ctx context.Context, e boil.ContextExecutor,
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load permissions")
+		return errors.Wrap(err, "failed to eager load effective_permissions")
 	}
 
-	var resultSlice []*Permission
-
-	var localJoinCols []string
-	for results.Next() {
-		one := new(Permission)
-		var localJoinCol string
-
-		err = results.Scan(&one.Target, &one.Description, &one.CreatedAt, &one.UpdatedAt, &localJoinCol)
-		if err != nil {
-			return errors.Wrap(err, "failed to scan eager loaded results for permissions")
-		}
-		if err = results.Err(); err != nil {
-			return errors.Wrap(err, "failed to plebian-bind eager loaded slice permissions")
-		}
-
-		resultSlice = append(resultSlice, one)
-		localJoinCols = append(localJoinCols, localJoinCol)
+	var resultSlice []*EffectivePermission
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice effective_permissions")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on permissions")
+		return errors.Wrap(err, "failed to close results in eager load on effective_permissions")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for permissions")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for effective_permissions")
 	}
 
-	if len(permissionAfterSelectHooks) != 0 {
+	if len(effectivePermissionAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -782,25 +887,24 @@ func (roleL) LoadTargetPermissions(ctx context.Context, e boil.ContextExecutor,
 		}
 	}
 	if singular {
-		object.R.TargetPermissions = resultSlice
+		object.R.FromRoleEffectivePermissions = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &permissionR{}
+				foreign.R = &effectivePermissionR{}
 			}
-			foreign.R.Roles = append(foreign.R.Roles, object)
+			foreign.R.FromRoleRole = object
 		}
 		return nil
 	}
 
-	for i, foreign := range resultSlice {
-		localJoinCol := localJoinCols[i]
+	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == localJoinCol {
-				local.R.TargetPermissions = append(local.R.TargetPermissions, foreign)
+			if local.ID == foreign.FromRole {
+				local.R.FromRoleEffectivePermissions = append(local.R.FromRoleEffectivePermissions, foreign)
 				if foreign.R == nil {
-					foreign.R = &permissionR{}
+					foreign.R = &effectivePermissionR{}
 				}
-				foreign.R.Roles = append(foreign.R.Roles, local)
+				foreign.R.FromRoleRole = local
 				break
 			}
 		}
@@ -809,76 +913,790 @@ func (roleL) LoadTargetPermissions(ctx context.Context, e boil.ContextExecutor,
 	return nil
 }
 
-// AddFromRoleEffectivePermissions adds the given related objects to the existing relationships
-// of the role, optionally inserting them as new records.
-// Appends related to o.R.FromRoleEffectivePermissions.
-// Sets related.R.FromRoleRole appropriately.
-func (o *Role) AddFromRoleEffectivePermissions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*EffectivePermission) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			rel.FromRole = o.ID
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
-			}
-		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE \"effective_permissions\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"from_role"}),
-				strmangle.WhereClause("\"", "\"", 2, effectivePermissionPrimaryKeyColumns),
-			)
-			values := []interface{}{o.ID, rel.SubjectID, rel.Target, rel.Scope}
+// LoadRoleAssignments allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (roleL) LoadRoleAssignments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRole interface{}, mods queries.Applicator) error {
+	var slice []*Role
+	var object *Role
 
-			if boil.IsDebug(ctx) {
-				writer := boil.DebugWriterFrom(ctx)
-				fmt.Fprintln(writer, updateQuery)
-				fmt.Fprintln(writer, values)
+	if singular {
+		var ok bool
+		object, ok = maybeRole.(*Role)
+		if !ok {
+			object = new(Role)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRole))
 			}
-			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-				return errors.Wrap(err, "failed to update foreign table")
+		}
+	} else {
+		s, ok := maybeRole.(*[]*Role)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRole))
 			}
-
-			rel.FromRole = o.ID
 		}
 	}
 
-	if o.R == nil {
-		o.R = &roleR{
-			FromRoleEffectivePermissions: related,
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &roleR{}
 		}
+		args = append(args, object.ID)
 	} else {
-		o.R.FromRoleEffectivePermissions = append(o.R.FromRoleEffectivePermissions, related...)
-	}
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &roleR{}
+			}
 
-	for _, rel := range related {
-		if rel.R == nil {
-			rel.R = &effectivePermissionR{
-				FromRoleRole: o,
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
 			}
-		} else {
-			rel.R.FromRoleRole = o
+
+			args = append(args, obj.ID)
 		}
 	}
-	return nil
-}
 
-// AddRoleAssignments adds the given related objects to the existing relationships
-// of the role, optionally inserting them as new records.
-// Appends related to o.R.RoleAssignments.
-// Sets related.R.Role appropriately.
-func (o *Role) AddRoleAssignments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RoleAssignment) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			rel.RoleID = o.ID
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
-			}
-		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE \"role_assignments\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"role_id"}),
-				strmangle.WhereClause("\"", "\"", 2, roleAssignmentPrimaryKeyColumns),
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`role_assignments`),
+		qm.WhereIn(`role_assignments.role_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load role_assignments")
+	}
+
+	var resultSlice []*RoleAssignment
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice role_assignments")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on role_assignments")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for role_assignments")
+	}
+
+	if len(roleAssignmentAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RoleAssignments = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &roleAssignmentR{}
+			}
+			foreign.R.Role = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.RoleID {
+				local.R.RoleAssignments = append(local.R.RoleAssignments, foreign)
+				if foreign.R == nil {
+					foreign.R = &roleAssignmentR{}
+				}
+				foreign.R.Role = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadIncludedRoleRoles allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (roleL) LoadIncludedRoleRoles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRole interface{}, mods queries.Applicator) error {
+	var slice []*Role
+	var object *Role
+
+	if singular {
+		var ok bool
+		object, ok = maybeRole.(*Role)
+		if !ok {
+			object = new(Role)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRole))
+			}
+		}
+	} else {
+		s, ok := maybeRole.(*[]*Role)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRole))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &roleR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &roleR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.Select("\"roles\".\"id\", \"roles\".\"name\", \"roles\".\"description\", \"roles\".\"created_at\", \"roles\".\"updated_at\", \"roles\".\"directory_id\", \"roles\".\"version\", \"a\".\"role_id\""),
+		qm.From("\"roles\""),
+		qm.InnerJoin("\"role_includes\" as \"a\" on \"roles\".\"id\" = \"a\".\"included_role_id\""),
+		qm.WhereIn("\"a\".\"role_id\" in ?", args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load roles")
+	}
+
+	var resultSlice []*Role
+
+	var localJoinCols []string
+	for results.Next() {
+		one := new(Role)
+		var localJoinCol string
+
+		err = results.Scan(&one.ID, &one.Name, &one.Description, &one.CreatedAt, &one.UpdatedAt, &one.DirectoryID, &one.Version, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for roles")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice roles")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on roles")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for roles")
+	}
+
+	if len(roleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.IncludedRoleRoles = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &roleR{}
+			}
+			foreign.R.Roles = append(foreign.R.Roles, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.IncludedRoleRoles = append(local.R.IncludedRoleRoles, foreign)
+				if foreign.R == nil {
+					foreign.R = &roleR{}
+				}
+				foreign.R.Roles = append(foreign.R.Roles, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRoles allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (roleL) LoadRoles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRole interface{}, mods queries.Applicator) error {
+	var slice []*Role
+	var object *Role
+
+	if singular {
+		var ok bool
+		object, ok = maybeRole.(*Role)
+		if !ok {
+			object = new(Role)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRole))
+			}
+		}
+	} else {
+		s, ok := maybeRole.(*[]*Role)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRole))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &roleR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &roleR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.Select("\"roles\".\"id\", \"roles\".\"name\", \"roles\".\"description\", \"roles\".\"created_at\", \"roles\".\"updated_at\", \"roles\".\"directory_id\", \"roles\".\"version\", \"a\".\"included_role_id\""),
+		qm.From("\"roles\""),
+		qm.InnerJoin("\"role_includes\" as \"a\" on \"roles\".\"id\" = \"a\".\"role_id\""),
+		qm.WhereIn("\"a\".\"included_role_id\" in ?", args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load roles")
+	}
+
+	var resultSlice []*Role
+
+	var localJoinCols []string
+	for results.Next() {
+		one := new(Role)
+		var localJoinCol string
+
+		err = results.Scan(&one.ID, &one.Name, &one.Description, &one.CreatedAt, &one.UpdatedAt, &one.DirectoryID, &one.Version, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for roles")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice roles")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on roles")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for roles")
+	}
+
+	if len(roleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Roles = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &roleR{}
+			}
+			foreign.R.IncludedRoleRoles = append(foreign.R.IncludedRoleRoles, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.Roles = append(local.R.Roles, foreign)
+				if foreign.R == nil {
+					foreign.R = &roleR{}
+				}
+				foreign.R.IncludedRoleRoles = append(foreign.R.IncludedRoleRoles, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTargetPermissions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (roleL) LoadTargetPermissions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRole interface{}, mods queries.Applicator) error {
+	var slice []*Role
+	var object *Role
+
+	if singular {
+		var ok bool
+		object, ok = maybeRole.(*Role)
+		if !ok {
+			object = new(Role)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRole))
+			}
+		}
+	} else {
+		s, ok := maybeRole.(*[]*Role)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRole))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &roleR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &roleR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.Select("\"permissions\".\"target\", \"permissions\".\"description\", \"permissions\".\"created_at\", \"permissions\".\"updated_at\", \"a\".\"role_id\""),
+		qm.From("\"permissions\""),
+		qm.InnerJoin("\"role_permissions\" as \"a\" on \"permissions\".\"target\" = \"a\".\"target\""),
+		qm.WhereIn("\"a\".\"role_id\" in ?", args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load permissions")
+	}
+
+	var resultSlice []*Permission
+
+	var localJoinCols []string
+	for results.Next() {
+		one := new(Permission)
+		var localJoinCol string
+
+		err = results.Scan(&one.Target, &one.Description, &one.CreatedAt, &one.UpdatedAt, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for permissions")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice permissions")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on permissions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for permissions")
+	}
+
+	if len(permissionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TargetPermissions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &permissionR{}
+			}
+			foreign.R.Roles = append(foreign.R.Roles, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.TargetPermissions = append(local.R.TargetPermissions, foreign)
+				if foreign.R == nil {
+					foreign.R = &permissionR{}
+				}
+				foreign.R.Roles = append(foreign.R.Roles, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetDirectory of the role to the related item.
+// Sets o.R.Directory to related.
+// Adds o to related.R.DirectoryRoles.
+func (o *Role) SetDirectory(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TrackedDirectory) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"roles\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"directory_id"}),
+		strmangle.WhereClause("\"", "\"", 2, rolePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.DirectoryID, related.ID)
+	if o.R == nil {
+		o.R = &roleR{
+			Directory: related,
+		}
+	} else {
+		o.R.Directory = related
+	}
+
+	if related.R == nil {
+		related.R = &trackedDirectoryR{
+			DirectoryRoles: RoleSlice{o},
+		}
+	} else {
+		related.R.DirectoryRoles = append(related.R.DirectoryRoles, o)
+	}
+
+	return nil
+}
+
+// RemoveDirectory relationship.
+// Sets o.R.Directory to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Role) RemoveDirectory(ctx context.Context, exec boil.ContextExecutor, related *TrackedDirectory) error {
+	var err error
+
+	queries.SetScanner(&o.DirectoryID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("directory_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Directory = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.DirectoryRoles {
+		if queries.Equal(o.DirectoryID, ri.DirectoryID) {
+			continue
+		}
+
+		ln := len(related.R.DirectoryRoles)
+		if ln > 1 && i < ln-1 {
+			related.R.DirectoryRoles[i] = related.R.DirectoryRoles[ln-1]
+		}
+		related.R.DirectoryRoles = related.R.DirectoryRoles[:ln-1]
+		break
+	}
+	return nil
+}
+
+// AddDenyRules adds the given related objects to the existing relationships
+// of the role, optionally inserting them as new records.
+// Appends related to o.R.DenyRules.
+// Sets related.R.Role appropriately.
+func (o *Role) AddDenyRules(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DenyRule) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.RoleID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"deny_rules\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"role_id"}),
+				strmangle.WhereClause("\"", "\"", 2, denyRulePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.RoleID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &roleR{
+			DenyRules: related,
+		}
+	} else {
+		o.R.DenyRules = append(o.R.DenyRules, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &denyRuleR{
+				Role: o,
+			}
+		} else {
+			rel.R.Role = o
+		}
+	}
+	return nil
+}
+
+// SetDenyRules removes all previously related items of the
+// role replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Role's DenyRules accordingly.
+// Replaces o.R.DenyRules with related.
+// Sets related.R.Role's DenyRules accordingly.
+func (o *Role) SetDenyRules(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DenyRule) error {
+	query := "update \"deny_rules\" set \"role_id\" = null where \"role_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.DenyRules {
+			queries.SetScanner(&rel.RoleID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Role = nil
+		}
+		o.R.DenyRules = nil
+	}
+
+	return o.AddDenyRules(ctx, exec, insert, related...)
+}
+
+// RemoveDenyRules relationships from objects passed in.
+// Removes related items from R.DenyRules (uses pointer comparison, removal does not keep order)
+// Sets related.R.Role.
+func (o *Role) RemoveDenyRules(ctx context.Context, exec boil.ContextExecutor, related ...*DenyRule) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.RoleID, nil)
+		if rel.R != nil {
+			rel.R.Role = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("role_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.DenyRules {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.DenyRules)
+			if ln > 1 && i < ln-1 {
+				o.R.DenyRules[i] = o.R.DenyRules[ln-1]
+			}
+			o.R.DenyRules = o.R.DenyRules[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddFromRoleEffectivePermissions adds the given related objects to the existing relationships
+// of the role, optionally inserting them as new records.
+// Appends related to o.R.FromRoleEffectivePermissions.
+// Sets related.R.FromRoleRole appropriately.
+func (o *Role) AddFromRoleEffectivePermissions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*EffectivePermission) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.FromRole = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"effective_permissions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"from_role"}),
+				strmangle.WhereClause("\"", "\"", 2, effectivePermissionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.SubjectID, rel.Target, rel.Scope}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.FromRole = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &roleR{
+			FromRoleEffectivePermissions: related,
+		}
+	} else {
+		o.R.FromRoleEffectivePermissions = append(o.R.FromRoleEffectivePermissions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &effectivePermissionR{
+				FromRoleRole: o,
+			}
+		} else {
+			rel.R.FromRoleRole = o
+		}
+	}
+	return nil
+}
+
+// AddRoleAssignments adds the given related objects to the existing relationships
+// of the role, optionally inserting them as new records.
+// Appends related to o.R.RoleAssignments.
+// Sets related.R.Role appropriately.
+func (o *Role) AddRoleAssignments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RoleAssignment) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.RoleID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"role_assignments\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"role_id"}),
+				strmangle.WhereClause("\"", "\"", 2, roleAssignmentPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -915,6 +1733,296 @@ func (o *Role) AddRoleAssignments(ctx context.Context, exec boil.ContextExecutor
 	return nil
 }
 
+// AddIncludedRoleRoles adds the given related objects to the existing relationships
+// of the role, optionally inserting them as new records.
+// Appends related to o.R.IncludedRoleRoles.
+// Sets related.R.Roles appropriately.
+func (o *Role) AddIncludedRoleRoles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Role) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into \"role_includes\" (\"role_id\", \"included_role_id\") values ($1, $2)"
+		values := []interface{}{o.ID, rel.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, query)
+			fmt.Fprintln(writer, values)
+		}
+		_, err = exec.ExecContext(ctx, query, values...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert into join table")
+		}
+	}
+	if o.R == nil {
+		o.R = &roleR{
+			IncludedRoleRoles: related,
+		}
+	} else {
+		o.R.IncludedRoleRoles = append(o.R.IncludedRoleRoles, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &roleR{
+				Roles: RoleSlice{o},
+			}
+		} else {
+			rel.R.Roles = append(rel.R.Roles, o)
+		}
+	}
+	return nil
+}
+
+// SetIncludedRoleRoles removes all previously related items of the
+// role replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Roles's IncludedRoleRoles accordingly.
+// Replaces o.R.IncludedRoleRoles with related.
+// Sets related.R.Roles's IncludedRoleRoles accordingly.
+func (o *Role) SetIncludedRoleRoles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Role) error {
+	query := "delete from \"role_includes\" where \"role_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	removeIncludedRoleRolesFromRolesSlice(o, related)
+	if o.R != nil {
+		o.R.IncludedRoleRoles = nil
+	}
+
+	return o.AddIncludedRoleRoles(ctx, exec, insert, related...)
+}
+
+// RemoveIncludedRoleRoles relationships from objects passed in.
+// Removes related items from R.IncludedRoleRoles (uses pointer comparison, removal does not keep order)
+// Sets related.R.Roles.
+func (o *Role) RemoveIncludedRoleRoles(ctx context.Context, exec boil.ContextExecutor, related ...*Role) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	query := fmt.Sprintf(
+		"delete from \"role_includes\" where \"role_id\" = $1 and \"included_role_id\" in (%s)",
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
+	)
+	values := []interface{}{o.ID}
+	for _, rel := range related {
+		values = append(values, rel.ID)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err = exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+	removeIncludedRoleRolesFromRolesSlice(o, related)
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.IncludedRoleRoles {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.IncludedRoleRoles)
+			if ln > 1 && i < ln-1 {
+				o.R.IncludedRoleRoles[i] = o.R.IncludedRoleRoles[ln-1]
+			}
+			o.R.IncludedRoleRoles = o.R.IncludedRoleRoles[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+func removeIncludedRoleRolesFromRolesSlice(o *Role, related []*Role) {
+	for _, rel := range related {
+		if rel.R == nil {
+			continue
+		}
+		for i, ri := range rel.R.Roles {
+			if o.ID != ri.ID {
+				continue
+			}
+
+			ln := len(rel.R.Roles)
+			if ln > 1 && i < ln-1 {
+				rel.R.Roles[i] = rel.R.Roles[ln-1]
+			}
+			rel.R.Roles = rel.R.Roles[:ln-1]
+			break
+		}
+	}
+}
+
+// AddRoles adds the given related objects to the existing relationships
+// of the role, optionally inserting them as new records.
+// Appends related to o.R.Roles.
+// Sets related.R.IncludedRoleRoles appropriately.
+func (o *Role) AddRoles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Role) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into \"role_includes\" (\"included_role_id\", \"role_id\") values ($1, $2)"
+		values := []interface{}{o.ID, rel.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, query)
+			fmt.Fprintln(writer, values)
+		}
+		_, err = exec.ExecContext(ctx, query, values...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert into join table")
+		}
+	}
+	if o.R == nil {
+		o.R = &roleR{
+			Roles: related,
+		}
+	} else {
+		o.R.Roles = append(o.R.Roles, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &roleR{
+				IncludedRoleRoles: RoleSlice{o},
+			}
+		} else {
+			rel.R.IncludedRoleRoles = append(rel.R.IncludedRoleRoles, o)
+		}
+	}
+	return nil
+}
+
+// SetRoles removes all previously related items of the
+// role replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.IncludedRoleRoles's Roles accordingly.
+// Replaces o.R.Roles with related.
+// Sets related.R.IncludedRoleRoles's Roles accordingly.
+func (o *Role) SetRoles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Role) error {
+	query := "delete from \"role_includes\" where \"included_role_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	removeRolesFromIncludedRoleRolesSlice(o, related)
+	if o.R != nil {
+		o.R.Roles = nil
+	}
+
+	return o.AddRoles(ctx, exec, insert, related...)
+}
+
+// RemoveRoles relationships from objects passed in.
+// Removes related items from R.Roles (uses pointer comparison, removal does not keep order)
+// Sets related.R.IncludedRoleRoles.
+func (o *Role) RemoveRoles(ctx context.Context, exec boil.ContextExecutor, related ...*Role) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	query := fmt.Sprintf(
+		"delete from \"role_includes\" where \"included_role_id\" = $1 and \"role_id\" in (%s)",
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
+	)
+	values := []interface{}{o.ID}
+	for _, rel := range related {
+		values = append(values, rel.ID)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err = exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+	removeRolesFromIncludedRoleRolesSlice(o, related)
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Roles {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Roles)
+			if ln > 1 && i < ln-1 {
+				o.R.Roles[i] = o.R.Roles[ln-1]
+			}
+			o.R.Roles = o.R.Roles[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+func removeRolesFromIncludedRoleRolesSlice(o *Role, related []*Role) {
+	for _, rel := range related {
+		if rel.R == nil {
+			continue
+		}
+		for i, ri := range rel.R.IncludedRoleRoles {
+			if o.ID != ri.ID {
+				continue
+			}
+
+			ln := len(rel.R.IncludedRoleRoles)
+			if ln > 1 && i < ln-1 {
+				rel.R.IncludedRoleRoles[i] = rel.R.IncludedRoleRoles[ln-1]
+			}
+			rel.R.IncludedRoleRoles = rel.R.IncludedRoleRoles[:ln-1]
+			break
+		}
+	}
+}
+
 // AddTargetPermissions adds the given related objects to the existing relationships
 // of the role, optionally inserting them as new records.
 // Appends related to o.R.TargetPermissions.
@@ -0,0 +1,1313 @@
+// Code generated by SQLBoiler 4.14.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// SubjectGroup is an object representing the database table.
+type SubjectGroup struct {
+	SubjectID   string    `boil:"subject_id" json:"subject_id" toml:"subject_id" yaml:"subject_id"`
+	Name        string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Description string    `boil:"description" json:"description" toml:"description" yaml:"description"`
+	CreatedAt   time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt   time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *subjectGroupR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L subjectGroupL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var SubjectGroupColumns = struct {
+	SubjectID   string
+	Name        string
+	Description string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	SubjectID:   "subject_id",
+	Name:        "name",
+	Description: "description",
+	CreatedAt:   "created_at",
+	UpdatedAt:   "updated_at",
+}
+
+var SubjectGroupTableColumns = struct {
+	SubjectID   string
+	Name        string
+	Description string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	SubjectID:   "subject_groups.subject_id",
+	Name:        "subject_groups.name",
+	Description: "subject_groups.description",
+	CreatedAt:   "subject_groups.created_at",
+	UpdatedAt:   "subject_groups.updated_at",
+}
+
+// Generated where
+
+var SubjectGroupWhere = struct {
+	SubjectID   whereHelperstring
+	Name        whereHelperstring
+	Description whereHelperstring
+	CreatedAt   whereHelpertime_Time
+	UpdatedAt   whereHelpertime_Time
+}{
+	SubjectID:   whereHelperstring{field: "\"subject_groups\".\"subject_id\""},
+	Name:        whereHelperstring{field: "\"subject_groups\".\"name\""},
+	Description: whereHelperstring{field: "\"subject_groups\".\"description\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"subject_groups\".\"created_at\""},
+	UpdatedAt:   whereHelpertime_Time{field: "\"subject_groups\".\"updated_at\""},
+}
+
+// SubjectGroupRels is where relationship names are stored.
+var SubjectGroupRels = struct {
+	Subject           string
+	GroupGroupMembers string
+}{
+	Subject:           "Subject",
+	GroupGroupMembers: "GroupGroupMembers",
+}
+
+// subjectGroupR is where relationships are stored.
+type subjectGroupR struct {
+	Subject           *TrackedSubject  `boil:"Subject" json:"Subject" toml:"Subject" yaml:"Subject"`
+	GroupGroupMembers GroupMemberSlice `boil:"GroupGroupMembers" json:"GroupGroupMembers" toml:"GroupGroupMembers" yaml:"GroupGroupMembers"`
+}
+
+// NewStruct creates a new relationship struct
+func (*subjectGroupR) NewStruct() *subjectGroupR {
+	return &subjectGroupR{}
+}
+
+func (r *subjectGroupR) GetSubject() *TrackedSubject {
+	if r == nil {
+		return nil
+	}
+	return r.Subject
+}
+
+func (r *subjectGroupR) GetGroupGroupMembers() GroupMemberSlice {
+	if r == nil {
+		return nil
+	}
+	return r.GroupGroupMembers
+}
+
+// subjectGroupL is where Load methods for each relationship are stored.
+type subjectGroupL struct{}
+
+var (
+	subjectGroupAllColumns            = []string{"subject_id", "name", "description", "created_at", "updated_at"}
+	subjectGroupColumnsWithoutDefault = []string{"subject_id", "name"}
+	subjectGroupColumnsWithDefault    = []string{"description", "created_at", "updated_at"}
+	subjectGroupPrimaryKeyColumns     = []string{"subject_id"}
+	subjectGroupGeneratedColumns      = []string{}
+)
+
+type (
+	// SubjectGroupSlice is an alias for a slice of pointers to SubjectGroup.
+	// This should almost always be used instead of []SubjectGroup.
+	SubjectGroupSlice []*SubjectGroup
+	// SubjectGroupHook is the signature for custom SubjectGroup hook methods
+	SubjectGroupHook func(context.Context, boil.ContextExecutor, *SubjectGroup) error
+
+	subjectGroupQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	subjectGroupType                 = reflect.TypeOf(&SubjectGroup{})
+	subjectGroupMapping              = queries.MakeStructMapping(subjectGroupType)
+	subjectGroupPrimaryKeyMapping, _ = queries.BindMapping(subjectGroupType, subjectGroupMapping, subjectGroupPrimaryKeyColumns)
+	subjectGroupInsertCacheMut       sync.RWMutex
+	subjectGroupInsertCache          = make(map[string]insertCache)
+	subjectGroupUpdateCacheMut       sync.RWMutex
+	subjectGroupUpdateCache          = make(map[string]updateCache)
+	subjectGroupUpsertCacheMut       sync.RWMutex
+	subjectGroupUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var subjectGroupAfterSelectHooks []SubjectGroupHook
+
+var subjectGroupBeforeInsertHooks []SubjectGroupHook
+var subjectGroupAfterInsertHooks []SubjectGroupHook
+
+var subjectGroupBeforeUpdateHooks []SubjectGroupHook
+var subjectGroupAfterUpdateHooks []SubjectGroupHook
+
+var subjectGroupBeforeDeleteHooks []SubjectGroupHook
+var subjectGroupAfterDeleteHooks []SubjectGroupHook
+
+var subjectGroupBeforeUpsertHooks []SubjectGroupHook
+var subjectGroupAfterUpsertHooks []SubjectGroupHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *SubjectGroup) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range subjectGroupAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *SubjectGroup) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range subjectGroupBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *SubjectGroup) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range subjectGroupAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *SubjectGroup) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range subjectGroupBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *SubjectGroup) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range subjectGroupAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *SubjectGroup) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range subjectGroupBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *SubjectGroup) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range subjectGroupAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *SubjectGroup) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range subjectGroupBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *SubjectGroup) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range subjectGroupAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddSubjectGroupHook registers your hook function for all future operations.
+func AddSubjectGroupHook(hookPoint boil.HookPoint, subjectGroupHook SubjectGroupHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		subjectGroupAfterSelectHooks = append(subjectGroupAfterSelectHooks, subjectGroupHook)
+	case boil.BeforeInsertHook:
+		subjectGroupBeforeInsertHooks = append(subjectGroupBeforeInsertHooks, subjectGroupHook)
+	case boil.AfterInsertHook:
+		subjectGroupAfterInsertHooks = append(subjectGroupAfterInsertHooks, subjectGroupHook)
+	case boil.BeforeUpdateHook:
+		subjectGroupBeforeUpdateHooks = append(subjectGroupBeforeUpdateHooks, subjectGroupHook)
+	case boil.AfterUpdateHook:
+		subjectGroupAfterUpdateHooks = append(subjectGroupAfterUpdateHooks, subjectGroupHook)
+	case boil.BeforeDeleteHook:
+		subjectGroupBeforeDeleteHooks = append(subjectGroupBeforeDeleteHooks, subjectGroupHook)
+	case boil.AfterDeleteHook:
+		subjectGroupAfterDeleteHooks = append(subjectGroupAfterDeleteHooks, subjectGroupHook)
+	case boil.BeforeUpsertHook:
+		subjectGroupBeforeUpsertHooks = append(subjectGroupBeforeUpsertHooks, subjectGroupHook)
+	case boil.AfterUpsertHook:
+		subjectGroupAfterUpsertHooks = append(subjectGroupAfterUpsertHooks, subjectGroupHook)
+	}
+}
+
+// One returns a single subjectGroup record from the query.
+func (q subjectGroupQuery) One(ctx context.Context, exec boil.ContextExecutor) (*SubjectGroup, error) {
+	o := &SubjectGroup{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to execute a one query for subject_groups")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all SubjectGroup records from the query.
+func (q subjectGroupQuery) All(ctx context.Context, exec boil.ContextExecutor) (SubjectGroupSlice, error) {
+	var o []*SubjectGroup
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to SubjectGroup slice")
+	}
+
+	if len(subjectGroupAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all SubjectGroup records in the query.
+func (q subjectGroupQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count subject_groups rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q subjectGroupQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if subject_groups exists")
+	}
+
+	return count > 0, nil
+}
+
+// Subject pointed to by the foreign key.
+func (o *SubjectGroup) Subject(mods ...qm.QueryMod) trackedSubjectQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"subject_id\" = ?", o.SubjectID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return TrackedSubjects(queryMods...)
+}
+
+// GroupGroupMembers retrieves all the group_member's GroupMembers with an executor via group_id column.
+func (o *SubjectGroup) GroupGroupMembers(mods ...qm.QueryMod) groupMemberQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"group_members\".\"group_id\"=?", o.SubjectID),
+	)
+
+	return GroupMembers(queryMods...)
+}
+
+// LoadSubject allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (subjectGroupL) LoadSubject(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSubjectGroup interface{}, mods queries.Applicator) error {
+	var slice []*SubjectGroup
+	var object *SubjectGroup
+
+	if singular {
+		var ok bool
+		object, ok = maybeSubjectGroup.(*SubjectGroup)
+		if !ok {
+			object = new(SubjectGroup)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSubjectGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSubjectGroup))
+			}
+		}
+	} else {
+		s, ok := maybeSubjectGroup.(*[]*SubjectGroup)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSubjectGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSubjectGroup))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &subjectGroupR{}
+		}
+		args = append(args, object.SubjectID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &subjectGroupR{}
+			}
+
+			for _, a := range args {
+				if a == obj.SubjectID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.SubjectID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`tracked_subjects`),
+		qm.WhereIn(`tracked_subjects.subject_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load TrackedSubject")
+	}
+
+	var resultSlice []*TrackedSubject
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice TrackedSubject")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tracked_subjects")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tracked_subjects")
+	}
+
+	if len(trackedSubjectAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Subject = foreign
+		if foreign.R == nil {
+			foreign.R = &trackedSubjectR{}
+		}
+		foreign.R.SubjectSubjectGroup = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.SubjectID == foreign.SubjectID {
+				local.R.Subject = foreign
+				if foreign.R == nil {
+					foreign.R = &trackedSubjectR{}
+				}
+				foreign.R.SubjectSubjectGroup = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadGroupGroupMembers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (subjectGroupL) LoadGroupGroupMembers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSubjectGroup interface{}, mods queries.Applicator) error {
+	var slice []*SubjectGroup
+	var object *SubjectGroup
+
+	if singular {
+		var ok bool
+		object, ok = maybeSubjectGroup.(*SubjectGroup)
+		if !ok {
+			object = new(SubjectGroup)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSubjectGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSubjectGroup))
+			}
+		}
+	} else {
+		s, ok := maybeSubjectGroup.(*[]*SubjectGroup)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSubjectGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSubjectGroup))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &subjectGroupR{}
+		}
+		args = append(args, object.SubjectID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &subjectGroupR{}
+			}
+
+			for _, a := range args {
+				if a == obj.SubjectID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.SubjectID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`group_members`),
+		qm.WhereIn(`group_members.group_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load group_members")
+	}
+
+	var resultSlice []*GroupMember
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice group_members")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on group_members")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for group_members")
+	}
+
+	if len(groupMemberAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.GroupGroupMembers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &groupMemberR{}
+			}
+			foreign.R.Group = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.SubjectID == foreign.GroupID {
+				local.R.GroupGroupMembers = append(local.R.GroupGroupMembers, foreign)
+				if foreign.R == nil {
+					foreign.R = &groupMemberR{}
+				}
+				foreign.R.Group = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetSubject of the subjectGroup to the related item.
+// Sets o.R.Subject to related.
+// Adds o to related.R.SubjectSubjectGroup.
+func (o *SubjectGroup) SetSubject(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TrackedSubject) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"subject_groups\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"subject_id"}),
+		strmangle.WhereClause("\"", "\"", 2, subjectGroupPrimaryKeyColumns),
+	)
+	values := []interface{}{related.SubjectID, o.SubjectID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.SubjectID = related.SubjectID
+	if o.R == nil {
+		o.R = &subjectGroupR{
+			Subject: related,
+		}
+	} else {
+		o.R.Subject = related
+	}
+
+	if related.R == nil {
+		related.R = &trackedSubjectR{
+			SubjectSubjectGroup: o,
+		}
+	} else {
+		related.R.SubjectSubjectGroup = o
+	}
+
+	return nil
+}
+
+// AddGroupGroupMembers adds the given related objects to the existing relationships
+// of the subject_group, optionally inserting them as new records.
+// Appends related to o.R.GroupGroupMembers.
+// Sets related.R.Group appropriately.
+func (o *SubjectGroup) AddGroupGroupMembers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*GroupMember) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.GroupID = o.SubjectID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"group_members\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"group_id"}),
+				strmangle.WhereClause("\"", "\"", 2, groupMemberPrimaryKeyColumns),
+			)
+			values := []interface{}{o.SubjectID, rel.GroupID, rel.MemberID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.GroupID = o.SubjectID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &subjectGroupR{
+			GroupGroupMembers: related,
+		}
+	} else {
+		o.R.GroupGroupMembers = append(o.R.GroupGroupMembers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &groupMemberR{
+				Group: o,
+			}
+		} else {
+			rel.R.Group = o
+		}
+	}
+	return nil
+}
+
+// SubjectGroups retrieves all the records using an executor.
+func SubjectGroups(mods ...qm.QueryMod) subjectGroupQuery {
+	mods = append(mods, qm.From("\"subject_groups\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"subject_groups\".*"})
+	}
+
+	return subjectGroupQuery{q}
+}
+
+// FindSubjectGroup retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindSubjectGroup(ctx context.Context, exec boil.ContextExecutor, subjectID string, selectCols ...string) (*SubjectGroup, error) {
+	subjectGroupObj := &SubjectGroup{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"subject_groups\" where \"subject_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, subjectID)
+
+	err := q.Bind(ctx, exec, subjectGroupObj)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: unable to select from subject_groups")
+	}
+
+	if err = subjectGroupObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return subjectGroupObj, err
+	}
+
+	return subjectGroupObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *SubjectGroup) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no subject_groups provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(subjectGroupColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	subjectGroupInsertCacheMut.RLock()
+	cache, cached := subjectGroupInsertCache[key]
+	subjectGroupInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			subjectGroupAllColumns,
+			subjectGroupColumnsWithDefault,
+			subjectGroupColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(subjectGroupType, subjectGroupMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(subjectGroupType, subjectGroupMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"subject_groups\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"subject_groups\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into subject_groups")
+	}
+
+	if !cached {
+		subjectGroupInsertCacheMut.Lock()
+		subjectGroupInsertCache[key] = cache
+		subjectGroupInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the SubjectGroup.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *SubjectGroup) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	subjectGroupUpdateCacheMut.RLock()
+	cache, cached := subjectGroupUpdateCache[key]
+	subjectGroupUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			subjectGroupAllColumns,
+			subjectGroupPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update subject_groups, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"subject_groups\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, subjectGroupPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(subjectGroupType, subjectGroupMapping, append(wl, subjectGroupPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update subject_groups row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for subject_groups")
+	}
+
+	if !cached {
+		subjectGroupUpdateCacheMut.Lock()
+		subjectGroupUpdateCache[key] = cache
+		subjectGroupUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q subjectGroupQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for subject_groups")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for subject_groups")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o SubjectGroupSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), subjectGroupPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"subject_groups\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, subjectGroupPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in subjectGroup slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all subjectGroup")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single SubjectGroup record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *SubjectGroup) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no SubjectGroup provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), subjectGroupPrimaryKeyMapping)
+	sql := "DELETE FROM \"subject_groups\" WHERE \"subject_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from subject_groups")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for subject_groups")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q subjectGroupQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no subjectGroupQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from subject_groups")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for subject_groups")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o SubjectGroupSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(subjectGroupBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), subjectGroupPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"subject_groups\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, subjectGroupPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from subjectGroup slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for subject_groups")
+	}
+
+	if len(subjectGroupAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *SubjectGroup) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindSubjectGroup(ctx, exec, o.SubjectID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SubjectGroupSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := SubjectGroupSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), subjectGroupPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"subject_groups\".* FROM \"subject_groups\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, subjectGroupPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in SubjectGroupSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// SubjectGroupExists checks if the SubjectGroup row exists.
+func SubjectGroupExists(ctx context.Context, exec boil.ContextExecutor, subjectID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"subject_groups\" where \"subject_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, subjectID)
+	}
+	row := exec.QueryRowContext(ctx, sql, subjectID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if subject_groups exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the SubjectGroup row exists.
+func (o *SubjectGroup) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return SubjectGroupExists(ctx, exec, o.SubjectID)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *SubjectGroup) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no subject_groups provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(subjectGroupColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	subjectGroupUpsertCacheMut.RLock()
+	cache, cached := subjectGroupUpsertCache[key]
+	subjectGroupUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			subjectGroupAllColumns,
+			subjectGroupColumnsWithDefault,
+			subjectGroupColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			subjectGroupAllColumns,
+			subjectGroupPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert subject_groups, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(subjectGroupPrimaryKeyColumns))
+			copy(conflict, subjectGroupPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryCockroachDB(dialect, "\"subject_groups\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(subjectGroupType, subjectGroupMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(subjectGroupType, subjectGroupMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		_, _ = fmt.Fprintln(boil.DebugWriter, cache.query)
+		_, _ = fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // CockcorachDB doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert subject_groups")
+	}
+
+	if !cached {
+		subjectGroupUpsertCacheMut.Lock()
+		subjectGroupUpsertCache[key] = cache
+		subjectGroupUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
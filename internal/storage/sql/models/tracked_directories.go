@@ -59,17 +59,26 @@ var TrackedDirectoryWhere = struct {
 
 // TrackedDirectoryRels is where relationship names are stored.
 var TrackedDirectoryRels = struct {
+	DirectoryDirectoryParent  string
+	ScopeDenyRules            string
 	ScopeEffectivePermissions string
 	ScopeRoleAssignments      string
+	DirectoryRoles            string
 }{
+	DirectoryDirectoryParent:  "DirectoryDirectoryParent",
+	ScopeDenyRules:            "ScopeDenyRules",
 	ScopeEffectivePermissions: "ScopeEffectivePermissions",
 	ScopeRoleAssignments:      "ScopeRoleAssignments",
+	DirectoryRoles:            "DirectoryRoles",
 }
 
 // trackedDirectoryR is where relationships are stored.
 type trackedDirectoryR struct {
+	DirectoryDirectoryParent  *DirectoryParent         `boil:"DirectoryDirectoryParent" json:"DirectoryDirectoryParent" toml:"DirectoryDirectoryParent" yaml:"DirectoryDirectoryParent"`
+	ScopeDenyRules            DenyRuleSlice            `boil:"ScopeDenyRules" json:"ScopeDenyRules" toml:"ScopeDenyRules" yaml:"ScopeDenyRules"`
 	ScopeEffectivePermissions EffectivePermissionSlice `boil:"ScopeEffectivePermissions" json:"ScopeEffectivePermissions" toml:"ScopeEffectivePermissions" yaml:"ScopeEffectivePermissions"`
 	ScopeRoleAssignments      RoleAssignmentSlice      `boil:"ScopeRoleAssignments" json:"ScopeRoleAssignments" toml:"ScopeRoleAssignments" yaml:"ScopeRoleAssignments"`
+	DirectoryRoles            RoleSlice                `boil:"DirectoryRoles" json:"DirectoryRoles" toml:"DirectoryRoles" yaml:"DirectoryRoles"`
 }
 
 // NewStruct creates a new relationship struct
@@ -77,6 +86,20 @@ func (*trackedDirectoryR) NewStruct() *trackedDirectoryR {
 	return &trackedDirectoryR{}
 }
 
+func (r *trackedDirectoryR) GetDirectoryDirectoryParent() *DirectoryParent {
+	if r == nil {
+		return nil
+	}
+	return r.DirectoryDirectoryParent
+}
+
+func (r *trackedDirectoryR) GetScopeDenyRules() DenyRuleSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ScopeDenyRules
+}
+
 func (r *trackedDirectoryR) GetScopeEffectivePermissions() EffectivePermissionSlice {
 	if r == nil {
 		return nil
@@ -91,6 +114,13 @@ func (r *trackedDirectoryR) GetScopeRoleAssignments() RoleAssignmentSlice {
 	return r.ScopeRoleAssignments
 }
 
+func (r *trackedDirectoryR) GetDirectoryRoles() RoleSlice {
+	if r == nil {
+		return nil
+	}
+	return r.DirectoryRoles
+}
+
 // trackedDirectoryL is where Load methods for each relationship are stored.
 type trackedDirectoryL struct{}
 
@@ -377,6 +407,31 @@ func (q trackedDirectoryQuery) Exists(ctx context.Context, exec boil.ContextExec
 	return count > 0, nil
 }
 
+// DirectoryDirectoryParent pointed to by the foreign key.
+func (o *TrackedDirectory) DirectoryDirectoryParent(mods ...qm.QueryMod) directoryParentQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"directory_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return DirectoryParents(queryMods...)
+}
+
+// ScopeDenyRules retrieves all the deny_rule's DenyRules with an executor via scope column.
+func (o *TrackedDirectory) ScopeDenyRules(mods ...qm.QueryMod) denyRuleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"deny_rules\".\"scope\"=?", o.ID),
+	)
+
+	return DenyRules(queryMods...)
+}
+
 // ScopeEffectivePermissions retrieves all the effective_permission's EffectivePermissions with an executor via scope column.
 func (o *TrackedDirectory) ScopeEffectivePermissions(mods ...qm.QueryMod) effectivePermissionQuery {
 	var queryMods []qm.QueryMod
@@ -405,6 +460,251 @@ func (o *TrackedDirectory) ScopeRoleAssignments(mods ...qm.QueryMod) roleAssignm
 	return RoleAssignments(queryMods...)
 }
 
+// DirectoryRoles retrieves all the role's Roles with an executor via directory_id column.
+func (o *TrackedDirectory) DirectoryRoles(mods ...qm.QueryMod) roleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"roles\".\"directory_id\"=?", o.ID),
+	)
+
+	return Roles(queryMods...)
+}
+
+// LoadDirectoryDirectoryParent allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (trackedDirectoryL) LoadDirectoryDirectoryParent(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTrackedDirectory interface{}, mods queries.Applicator) error {
+	var slice []*TrackedDirectory
+	var object *TrackedDirectory
+
+	if singular {
+		var ok bool
+		object, ok = maybeTrackedDirectory.(*TrackedDirectory)
+		if !ok {
+			object = new(TrackedDirectory)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTrackedDirectory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTrackedDirectory))
+			}
+		}
+	} else {
+		s, ok := maybeTrackedDirectory.(*[]*TrackedDirectory)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTrackedDirectory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTrackedDirectory))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &trackedDirectoryR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &trackedDirectoryR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`directory_parents`),
+		qm.WhereIn(`directory_parents.directory_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load DirectoryParent")
+	}
+
+	var resultSlice []*DirectoryParent
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice DirectoryParent")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for directory_parents")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for directory_parents")
+	}
+
+	if len(directoryParentAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.DirectoryDirectoryParent = foreign
+		if foreign.R == nil {
+			foreign.R = &directoryParentR{}
+		}
+		foreign.R.Directory = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.DirectoryID {
+				local.R.DirectoryDirectoryParent = foreign
+				if foreign.R == nil {
+					foreign.R = &directoryParentR{}
+				}
+				foreign.R.Directory = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadScopeDenyRules allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (trackedDirectoryL) LoadScopeDenyRules(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTrackedDirectory interface{}, mods queries.Applicator) error {
+	var slice []*TrackedDirectory
+	var object *TrackedDirectory
+
+	if singular {
+		var ok bool
+		object, ok = maybeTrackedDirectory.(*TrackedDirectory)
+		if !ok {
+			object = new(TrackedDirectory)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTrackedDirectory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTrackedDirectory))
+			}
+		}
+	} else {
+		s, ok := maybeTrackedDirectory.(*[]*TrackedDirectory)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTrackedDirectory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTrackedDirectory))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &trackedDirectoryR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &trackedDirectoryR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`deny_rules`),
+		qm.WhereIn(`deny_rules.scope in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load deny_rules")
+	}
+
+	var resultSlice []*DenyRule
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice deny_rules")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on deny_rules")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for deny_rules")
+	}
+
+	if len(denyRuleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ScopeDenyRules = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &denyRuleR{}
+			}
+			foreign.R.ScopeTrackedDirectory = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.Scope {
+				local.R.ScopeDenyRules = append(local.R.ScopeDenyRules, foreign)
+				if foreign.R == nil {
+					foreign.R = &denyRuleR{}
+				}
+				foreign.R.ScopeTrackedDirectory = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadScopeEffectivePermissions allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (trackedDirectoryL) LoadScopeEffectivePermissions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTrackedDirectory interface{}, mods queries.Applicator) error {
@@ -633,6 +933,223 @@ func (trackedDirectoryL) LoadScopeRoleAssignments(ctx context.Context, e boil.Co
 	return nil
 }
 
+// LoadDirectoryRoles allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (trackedDirectoryL) LoadDirectoryRoles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTrackedDirectory interface{}, mods queries.Applicator) error {
+	var slice []*TrackedDirectory
+	var object *TrackedDirectory
+
+	if singular {
+		var ok bool
+		object, ok = maybeTrackedDirectory.(*TrackedDirectory)
+		if !ok {
+			object = new(TrackedDirectory)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTrackedDirectory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTrackedDirectory))
+			}
+		}
+	} else {
+		s, ok := maybeTrackedDirectory.(*[]*TrackedDirectory)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTrackedDirectory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTrackedDirectory))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &trackedDirectoryR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &trackedDirectoryR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`roles`),
+		qm.WhereIn(`roles.directory_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load roles")
+	}
+
+	var resultSlice []*Role
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice roles")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on roles")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for roles")
+	}
+
+	if len(roleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.DirectoryRoles = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &roleR{}
+			}
+			foreign.R.Directory = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.DirectoryID) {
+				local.R.DirectoryRoles = append(local.R.DirectoryRoles, foreign)
+				if foreign.R == nil {
+					foreign.R = &roleR{}
+				}
+				foreign.R.Directory = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetDirectoryDirectoryParent of the trackedDirectory to the related item.
+// Sets o.R.DirectoryDirectoryParent to related.
+// Adds o to related.R.Directory.
+func (o *TrackedDirectory) SetDirectoryDirectoryParent(ctx context.Context, exec boil.ContextExecutor, insert bool, related *DirectoryParent) error {
+	var err error
+
+	if insert {
+		related.DirectoryID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"directory_parents\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"directory_id"}),
+			strmangle.WhereClause("\"", "\"", 2, directoryParentPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.DirectoryID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.DirectoryID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &trackedDirectoryR{
+			DirectoryDirectoryParent: related,
+		}
+	} else {
+		o.R.DirectoryDirectoryParent = related
+	}
+
+	if related.R == nil {
+		related.R = &directoryParentR{
+			Directory: o,
+		}
+	} else {
+		related.R.Directory = o
+	}
+	return nil
+}
+
+// AddScopeDenyRules adds the given related objects to the existing relationships
+// of the tracked_directory, optionally inserting them as new records.
+// Appends related to o.R.ScopeDenyRules.
+// Sets related.R.ScopeTrackedDirectory appropriately.
+func (o *TrackedDirectory) AddScopeDenyRules(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DenyRule) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.Scope = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"deny_rules\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"scope"}),
+				strmangle.WhereClause("\"", "\"", 2, denyRulePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.Scope = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &trackedDirectoryR{
+			ScopeDenyRules: related,
+		}
+	} else {
+		o.R.ScopeDenyRules = append(o.R.ScopeDenyRules, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &denyRuleR{
+				ScopeTrackedDirectory: o,
+			}
+		} else {
+			rel.R.ScopeTrackedDirectory = o
+		}
+	}
+	return nil
+}
+
 // AddScopeEffectivePermissions adds the given related objects to the existing relationships
 // of the tracked_directory, optionally inserting them as new records.
 // Appends related to o.R.ScopeEffectivePermissions.
@@ -739,6 +1256,133 @@ func (o *TrackedDirectory) AddScopeRoleAssignments(ctx context.Context, exec boi
 	return nil
 }
 
+// AddDirectoryRoles adds the given related objects to the existing relationships
+// of the tracked_directory, optionally inserting them as new records.
+// Appends related to o.R.DirectoryRoles.
+// Sets related.R.Directory appropriately.
+func (o *TrackedDirectory) AddDirectoryRoles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Role) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.DirectoryID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"roles\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"directory_id"}),
+				strmangle.WhereClause("\"", "\"", 2, rolePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.DirectoryID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &trackedDirectoryR{
+			DirectoryRoles: related,
+		}
+	} else {
+		o.R.DirectoryRoles = append(o.R.DirectoryRoles, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &roleR{
+				Directory: o,
+			}
+		} else {
+			rel.R.Directory = o
+		}
+	}
+	return nil
+}
+
+// SetDirectoryRoles removes all previously related items of the
+// tracked_directory replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Directory's DirectoryRoles accordingly.
+// Replaces o.R.DirectoryRoles with related.
+// Sets related.R.Directory's DirectoryRoles accordingly.
+func (o *TrackedDirectory) SetDirectoryRoles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Role) error {
+	query := "update \"roles\" set \"directory_id\" = null where \"directory_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.DirectoryRoles {
+			queries.SetScanner(&rel.DirectoryID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Directory = nil
+		}
+		o.R.DirectoryRoles = nil
+	}
+
+	return o.AddDirectoryRoles(ctx, exec, insert, related...)
+}
+
+// RemoveDirectoryRoles relationships from objects passed in.
+// Removes related items from R.DirectoryRoles (uses pointer comparison, removal does not keep order)
+// Sets related.R.Directory.
+func (o *TrackedDirectory) RemoveDirectoryRoles(ctx context.Context, exec boil.ContextExecutor, related ...*Role) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.DirectoryID, nil)
+		if rel.R != nil {
+			rel.R.Directory = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("directory_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.DirectoryRoles {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.DirectoryRoles)
+			if ln > 1 && i < ln-1 {
+				o.R.DirectoryRoles[i] = o.R.DirectoryRoles[ln-1]
+			}
+			o.R.DirectoryRoles = o.R.DirectoryRoles[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // TrackedDirectories retrieves all the records using an executor.
 func TrackedDirectories(mods ...qm.QueryMod) trackedDirectoryQuery {
 	mods = append(mods, qm.From("\"tracked_directories\""), qmhelper.WhereIsNull("\"tracked_directories\".\"deleted_at\""))
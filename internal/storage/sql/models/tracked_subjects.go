@@ -51,16 +51,25 @@ var TrackedSubjectWhere = struct {
 
 // TrackedSubjectRels is where relationship names are stored.
 var TrackedSubjectRels = struct {
+	SubjectSubjectGroup         string
+	SubjectDenyRules            string
 	SubjectEffectivePermissions string
+	MemberGroupMembers          string
 	SubjectRoleAssignments      string
 }{
+	SubjectSubjectGroup:         "SubjectSubjectGroup",
+	SubjectDenyRules:            "SubjectDenyRules",
 	SubjectEffectivePermissions: "SubjectEffectivePermissions",
+	MemberGroupMembers:          "MemberGroupMembers",
 	SubjectRoleAssignments:      "SubjectRoleAssignments",
 }
 
 // trackedSubjectR is where relationships are stored.
 type trackedSubjectR struct {
+	SubjectSubjectGroup         *SubjectGroup            `boil:"SubjectSubjectGroup" json:"SubjectSubjectGroup" toml:"SubjectSubjectGroup" yaml:"SubjectSubjectGroup"`
+	SubjectDenyRules            DenyRuleSlice            `boil:"SubjectDenyRules" json:"SubjectDenyRules" toml:"SubjectDenyRules" yaml:"SubjectDenyRules"`
 	SubjectEffectivePermissions EffectivePermissionSlice `boil:"SubjectEffectivePermissions" json:"SubjectEffectivePermissions" toml:"SubjectEffectivePermissions" yaml:"SubjectEffectivePermissions"`
+	MemberGroupMembers          GroupMemberSlice         `boil:"MemberGroupMembers" json:"MemberGroupMembers" toml:"MemberGroupMembers" yaml:"MemberGroupMembers"`
 	SubjectRoleAssignments      RoleAssignmentSlice      `boil:"SubjectRoleAssignments" json:"SubjectRoleAssignments" toml:"SubjectRoleAssignments" yaml:"SubjectRoleAssignments"`
 }
 
@@ -69,6 +78,20 @@ func (*trackedSubjectR) NewStruct() *trackedSubjectR {
 	return &trackedSubjectR{}
 }
 
+func (r *trackedSubjectR) GetSubjectSubjectGroup() *SubjectGroup {
+	if r == nil {
+		return nil
+	}
+	return r.SubjectSubjectGroup
+}
+
+func (r *trackedSubjectR) GetSubjectDenyRules() DenyRuleSlice {
+	if r == nil {
+		return nil
+	}
+	return r.SubjectDenyRules
+}
+
 func (r *trackedSubjectR) GetSubjectEffectivePermissions() EffectivePermissionSlice {
 	if r == nil {
 		return nil
@@ -76,6 +99,13 @@ func (r *trackedSubjectR) GetSubjectEffectivePermissions() EffectivePermissionSl
 	return r.SubjectEffectivePermissions
 }
 
+func (r *trackedSubjectR) GetMemberGroupMembers() GroupMemberSlice {
+	if r == nil {
+		return nil
+	}
+	return r.MemberGroupMembers
+}
+
 func (r *trackedSubjectR) GetSubjectRoleAssignments() RoleAssignmentSlice {
 	if r == nil {
 		return nil
@@ -369,6 +399,31 @@ func (q trackedSubjectQuery) Exists(ctx context.Context, exec boil.ContextExecut
 	return count > 0, nil
 }
 
+// SubjectSubjectGroup pointed to by the foreign key.
+func (o *TrackedSubject) SubjectSubjectGroup(mods ...qm.QueryMod) subjectGroupQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"subject_id\" = ?", o.SubjectID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return SubjectGroups(queryMods...)
+}
+
+// SubjectDenyRules retrieves all the deny_rule's DenyRules with an executor via subject_id column.
+func (o *TrackedSubject) SubjectDenyRules(mods ...qm.QueryMod) denyRuleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"deny_rules\".\"subject_id\"=?", o.SubjectID),
+	)
+
+	return DenyRules(queryMods...)
+}
+
 // SubjectEffectivePermissions retrieves all the effective_permission's EffectivePermissions with an executor via subject_id column.
 func (o *TrackedSubject) SubjectEffectivePermissions(mods ...qm.QueryMod) effectivePermissionQuery {
 	var queryMods []qm.QueryMod
@@ -383,6 +438,20 @@ func (o *TrackedSubject) SubjectEffectivePermissions(mods ...qm.QueryMod) effect
 	return EffectivePermissions(queryMods...)
 }
 
+// MemberGroupMembers retrieves all the group_member's GroupMembers with an executor via member_id column.
+func (o *TrackedSubject) MemberGroupMembers(mods ...qm.QueryMod) groupMemberQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"group_members\".\"member_id\"=?", o.SubjectID),
+	)
+
+	return GroupMembers(queryMods...)
+}
+
 // SubjectRoleAssignments retrieves all the role_assignment's RoleAssignments with an executor via subject_id column.
 func (o *TrackedSubject) SubjectRoleAssignments(mods ...qm.QueryMod) roleAssignmentQuery {
 	var queryMods []qm.QueryMod
@@ -397,6 +466,237 @@ func (o *TrackedSubject) SubjectRoleAssignments(mods ...qm.QueryMod) roleAssignm
 	return RoleAssignments(queryMods...)
 }
 
+// LoadSubjectSubjectGroup allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (trackedSubjectL) LoadSubjectSubjectGroup(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTrackedSubject interface{}, mods queries.Applicator) error {
+	var slice []*TrackedSubject
+	var object *TrackedSubject
+
+	if singular {
+		var ok bool
+		object, ok = maybeTrackedSubject.(*TrackedSubject)
+		if !ok {
+			object = new(TrackedSubject)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTrackedSubject)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTrackedSubject))
+			}
+		}
+	} else {
+		s, ok := maybeTrackedSubject.(*[]*TrackedSubject)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTrackedSubject)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTrackedSubject))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &trackedSubjectR{}
+		}
+		args = append(args, object.SubjectID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &trackedSubjectR{}
+			}
+
+			for _, a := range args {
+				if a == obj.SubjectID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.SubjectID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`subject_groups`),
+		qm.WhereIn(`subject_groups.subject_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load SubjectGroup")
+	}
+
+	var resultSlice []*SubjectGroup
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice SubjectGroup")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for subject_groups")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for subject_groups")
+	}
+
+	if len(subjectGroupAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.SubjectSubjectGroup = foreign
+		if foreign.R == nil {
+			foreign.R = &subjectGroupR{}
+		}
+		foreign.R.Subject = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.SubjectID == foreign.SubjectID {
+				local.R.SubjectSubjectGroup = foreign
+				if foreign.R == nil {
+					foreign.R = &subjectGroupR{}
+				}
+				foreign.R.Subject = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSubjectDenyRules allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (trackedSubjectL) LoadSubjectDenyRules(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTrackedSubject interface{}, mods queries.Applicator) error {
+	var slice []*TrackedSubject
+	var object *TrackedSubject
+
+	if singular {
+		var ok bool
+		object, ok = maybeTrackedSubject.(*TrackedSubject)
+		if !ok {
+			object = new(TrackedSubject)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTrackedSubject)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTrackedSubject))
+			}
+		}
+	} else {
+		s, ok := maybeTrackedSubject.(*[]*TrackedSubject)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTrackedSubject)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTrackedSubject))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &trackedSubjectR{}
+		}
+		args = append(args, object.SubjectID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &trackedSubjectR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.SubjectID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.SubjectID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`deny_rules`),
+		qm.WhereIn(`deny_rules.subject_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load deny_rules")
+	}
+
+	var resultSlice []*DenyRule
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice deny_rules")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on deny_rules")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for deny_rules")
+	}
+
+	if len(denyRuleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SubjectDenyRules = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &denyRuleR{}
+			}
+			foreign.R.Subject = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.SubjectID, foreign.SubjectID) {
+				local.R.SubjectDenyRules = append(local.R.SubjectDenyRules, foreign)
+				if foreign.R == nil {
+					foreign.R = &denyRuleR{}
+				}
+				foreign.R.Subject = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadSubjectEffectivePermissions allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (trackedSubjectL) LoadSubjectEffectivePermissions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTrackedSubject interface{}, mods queries.Applicator) error {
@@ -511,6 +811,120 @@ func (trackedSubjectL) LoadSubjectEffectivePermissions(ctx context.Context, e bo
 	return nil
 }
 
+// LoadMemberGroupMembers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (trackedSubjectL) LoadMemberGroupMembers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTrackedSubject interface{}, mods queries.Applicator) error {
+	var slice []*TrackedSubject
+	var object *TrackedSubject
+
+	if singular {
+		var ok bool
+		object, ok = maybeTrackedSubject.(*TrackedSubject)
+		if !ok {
+			object = new(TrackedSubject)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTrackedSubject)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTrackedSubject))
+			}
+		}
+	} else {
+		s, ok := maybeTrackedSubject.(*[]*TrackedSubject)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTrackedSubject)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTrackedSubject))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &trackedSubjectR{}
+		}
+		args = append(args, object.SubjectID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &trackedSubjectR{}
+			}
+
+			for _, a := range args {
+				if a == obj.SubjectID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.SubjectID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`group_members`),
+		qm.WhereIn(`group_members.member_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load group_members")
+	}
+
+	var resultSlice []*GroupMember
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice group_members")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on group_members")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for group_members")
+	}
+
+	if len(groupMemberAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.MemberGroupMembers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &groupMemberR{}
+			}
+			foreign.R.Member = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.SubjectID == foreign.MemberID {
+				local.R.MemberGroupMembers = append(local.R.MemberGroupMembers, foreign)
+				if foreign.R == nil {
+					foreign.R = &groupMemberR{}
+				}
+				foreign.R.Member = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadSubjectRoleAssignments allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (trackedSubjectL) LoadSubjectRoleAssignments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTrackedSubject interface{}, mods queries.Applicator) error {
@@ -625,6 +1039,183 @@ func (trackedSubjectL) LoadSubjectRoleAssignments(ctx context.Context, e boil.Co
 	return nil
 }
 
+// SetSubjectSubjectGroup of the trackedSubject to the related item.
+// Sets o.R.SubjectSubjectGroup to related.
+// Adds o to related.R.Subject.
+func (o *TrackedSubject) SetSubjectSubjectGroup(ctx context.Context, exec boil.ContextExecutor, insert bool, related *SubjectGroup) error {
+	var err error
+
+	if insert {
+		related.SubjectID = o.SubjectID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"subject_groups\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"subject_id"}),
+			strmangle.WhereClause("\"", "\"", 2, subjectGroupPrimaryKeyColumns),
+		)
+		values := []interface{}{o.SubjectID, related.SubjectID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.SubjectID = o.SubjectID
+	}
+
+	if o.R == nil {
+		o.R = &trackedSubjectR{
+			SubjectSubjectGroup: related,
+		}
+	} else {
+		o.R.SubjectSubjectGroup = related
+	}
+
+	if related.R == nil {
+		related.R = &subjectGroupR{
+			Subject: o,
+		}
+	} else {
+		related.R.Subject = o
+	}
+	return nil
+}
+
+// AddSubjectDenyRules adds the given related objects to the existing relationships
+// of the tracked_subject, optionally inserting them as new records.
+// Appends related to o.R.SubjectDenyRules.
+// Sets related.R.Subject appropriately.
+func (o *TrackedSubject) AddSubjectDenyRules(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DenyRule) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.SubjectID, o.SubjectID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"deny_rules\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"subject_id"}),
+				strmangle.WhereClause("\"", "\"", 2, denyRulePrimaryKeyColumns),
+			)
+			values := []interface{}{o.SubjectID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.SubjectID, o.SubjectID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &trackedSubjectR{
+			SubjectDenyRules: related,
+		}
+	} else {
+		o.R.SubjectDenyRules = append(o.R.SubjectDenyRules, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &denyRuleR{
+				Subject: o,
+			}
+		} else {
+			rel.R.Subject = o
+		}
+	}
+	return nil
+}
+
+// SetSubjectDenyRules removes all previously related items of the
+// tracked_subject replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Subject's SubjectDenyRules accordingly.
+// Replaces o.R.SubjectDenyRules with related.
+// Sets related.R.Subject's SubjectDenyRules accordingly.
+func (o *TrackedSubject) SetSubjectDenyRules(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DenyRule) error {
+	query := "update \"deny_rules\" set \"subject_id\" = null where \"subject_id\" = $1"
+	values := []interface{}{o.SubjectID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.SubjectDenyRules {
+			queries.SetScanner(&rel.SubjectID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Subject = nil
+		}
+		o.R.SubjectDenyRules = nil
+	}
+
+	return o.AddSubjectDenyRules(ctx, exec, insert, related...)
+}
+
+// RemoveSubjectDenyRules relationships from objects passed in.
+// Removes related items from R.SubjectDenyRules (uses pointer comparison, removal does not keep order)
+// Sets related.R.Subject.
+func (o *TrackedSubject) RemoveSubjectDenyRules(ctx context.Context, exec boil.ContextExecutor, related ...*DenyRule) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.SubjectID, nil)
+		if rel.R != nil {
+			rel.R.Subject = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("subject_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.SubjectDenyRules {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.SubjectDenyRules)
+			if ln > 1 && i < ln-1 {
+				o.R.SubjectDenyRules[i] = o.R.SubjectDenyRules[ln-1]
+			}
+			o.R.SubjectDenyRules = o.R.SubjectDenyRules[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // AddSubjectEffectivePermissions adds the given related objects to the existing relationships
 // of the tracked_subject, optionally inserting them as new records.
 // Appends related to o.R.SubjectEffectivePermissions.
@@ -678,6 +1269,59 @@ func (o *TrackedSubject) AddSubjectEffectivePermissions(ctx context.Context, exe
 	return nil
 }
 
+// AddMemberGroupMembers adds the given related objects to the existing relationships
+// of the tracked_subject, optionally inserting them as new records.
+// Appends related to o.R.MemberGroupMembers.
+// Sets related.R.Member appropriately.
+func (o *TrackedSubject) AddMemberGroupMembers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*GroupMember) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.MemberID = o.SubjectID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"group_members\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"member_id"}),
+				strmangle.WhereClause("\"", "\"", 2, groupMemberPrimaryKeyColumns),
+			)
+			values := []interface{}{o.SubjectID, rel.GroupID, rel.MemberID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.MemberID = o.SubjectID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &trackedSubjectR{
+			MemberGroupMembers: related,
+		}
+	} else {
+		o.R.MemberGroupMembers = append(o.R.MemberGroupMembers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &groupMemberR{
+				Member: o,
+			}
+		} else {
+			rel.R.Member = o
+		}
+	}
+	return nil
+}
+
 // AddSubjectRoleAssignments adds the given related objects to the existing relationships
 // of the tracked_subject, optionally inserting them as new records.
 // Appends related to o.R.SubjectRoleAssignments.
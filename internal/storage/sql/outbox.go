@@ -0,0 +1,113 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+	"github.com/infratographer/lmi/internal/storage"
+	"github.com/infratographer/lmi/internal/storage/sql/models"
+)
+
+func (drv *sqlDriver) RelayEvents(
+	c context.Context,
+	limit int,
+	deliver func(evt storage.OutboxEvent) error,
+) (int, error) {
+	// Delivering has side effects, so the transaction isn't retried
+	// automatically: failed relays are retried by the caller instead.
+	tx, err := drv.db.BeginTx(c, nil)
+	if err != nil {
+		return 0, fmt.Errorf("couldn't start relaying events: %w", err)
+	}
+
+	defer tx.Rollback() //nolint:errcheck // the error doesn't matter once committed
+
+	pending, err := pendingEvents(c, tx, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	delivered := 0
+
+	for _, evt := range pending {
+		if deliverErr := deliver(outboxEvent(evt)); deliverErr != nil {
+			evt.Attempts++
+			evt.LastError = deliverErr.Error()
+
+			_, err := evt.Update(c, tx, boil.Whitelist(
+				models.EventOutboxColumns.Attempts,
+				models.EventOutboxColumns.LastError,
+			))
+			if err != nil {
+				return 0, fmt.Errorf("couldn't record failure of event %d: %w", evt.ID, err)
+			}
+
+			break
+		}
+
+		evt.Attempts++
+		evt.LastError = ""
+		evt.DeliveredAt = null.TimeFrom(time.Now())
+
+		_, err := evt.Update(c, tx, boil.Whitelist(
+			models.EventOutboxColumns.Attempts,
+			models.EventOutboxColumns.LastError,
+			models.EventOutboxColumns.DeliveredAt,
+		))
+		if err != nil {
+			return 0, fmt.Errorf("couldn't mark event %d delivered: %w", evt.ID, err)
+		}
+
+		delivered++
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("couldn't finish relaying events: %w", err)
+	}
+
+	return delivered, nil
+}
+
+func (drv *sqlDriver) PruneEvents(c context.Context, before time.Time) (int64, error) {
+	rows, err := models.EventOutboxes(
+		models.EventOutboxWhere.DeliveredAt.IsNotNull(),
+		models.EventOutboxWhere.DeliveredAt.LT(null.TimeFrom(before)),
+	).DeleteAll(c, drv.db)
+	if err != nil {
+		return 0, fmt.Errorf("couldn't prune delivered events: %w", err)
+	}
+
+	return rows, nil
+}
+
+// pendingEvents gets the oldest undelivered events, locking them until
+// they're relayed.
+func pendingEvents(c context.Context, tx *sql.Tx, limit int) (models.EventOutboxSlice, error) {
+	pending, err := models.EventOutboxes(
+		models.EventOutboxWhere.DeliveredAt.IsNull(),
+		qm.OrderBy(models.EventOutboxColumns.ID),
+		qm.Limit(limit),
+		qm.For("UPDATE"),
+	).All(c, tx)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get pending events: %w", err)
+	}
+
+	return pending, nil
+}
+
+func outboxEvent(evt *models.EventOutbox) storage.OutboxEvent {
+	return storage.OutboxEvent{
+		ID:       evt.ID,
+		Type:     apiv1.EventType(evt.EventType),
+		Payload:  evt.Payload,
+		Attempts: evt.Attempts,
+	}
+}
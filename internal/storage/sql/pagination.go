@@ -0,0 +1,240 @@
+package sql
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+	"github.com/infratographer/lmi/internal/storage"
+)
+
+const (
+	// defaultPageLimit is how many results are returned at once if no
+	// limit is given.
+	defaultPageLimit = 100
+
+	// maxPageLimit is the most results returned at once.
+	maxPageLimit = 1000
+)
+
+// likeEscaper escapes the LIKE wildcards in a pattern.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// sortColumn is a column results are sorted on, along with the SQL type
+// its values in cursors are cast to.
+type sortColumn struct {
+	name string
+	typ  string
+}
+
+// pageCursor points to the last result of a page. It's only valid for the
+// sort it was returned for.
+type pageCursor struct {
+	Sort  string `json:"s"`
+	Desc  bool   `json:"d,omitempty"`
+	Value string `json:"v"`
+	Key   string `json:"k"`
+}
+
+// listQuery builds the query returning a page of results. Results are
+// sorted on a column, with a unique key column breaking ties, so that
+// pages start right after the last result of the previous page even when
+// results are added or removed in between.
+type listQuery struct {
+	// with is a WITH clause numbering its own placeholders from $1, for
+	// withArgs.
+	with     string
+	withArgs []interface{}
+
+	columns string
+	from    string
+
+	// conds use ? placeholders, for args
+	conds []string
+	args  []interface{}
+
+	sort   string
+	column sortColumn
+	key    sortColumn
+	desc   bool
+	limit  int
+	after  *pageCursor
+}
+
+// newListQuery returns the query returning the page at the cursor, if
+// any, with results sorted as asked. sort is the name of the sort in the
+// API, which must be one of columns.
+func newListQuery(
+	sort string,
+	columns map[string]sortColumn,
+	key sortColumn,
+	order *apiv1.SortOrder,
+	limit *int,
+	cursor *string,
+) (*listQuery, error) {
+	column, ok := columns[sort]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", storage.ErrInvalidSort, sort)
+	}
+
+	q := &listQuery{
+		columns: "*",
+		sort:    sort,
+		column:  column,
+		key:     key,
+		limit:   defaultPageLimit,
+	}
+
+	if order != nil {
+		switch *order {
+		case apiv1.Asc:
+		case apiv1.Desc:
+			q.desc = true
+		default:
+			return nil, fmt.Errorf("%w: unknown order %s", storage.ErrInvalidSort, *order)
+		}
+	}
+
+	if limit != nil && *limit > 0 {
+		q.limit = *limit
+	}
+
+	if q.limit > maxPageLimit {
+		q.limit = maxPageLimit
+	}
+
+	if cursor != nil {
+		after, err := decodePageCursor(*cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		if after.Sort != q.sort || after.Desc != q.desc {
+			return nil, fmt.Errorf("%w: %s was returned for another sort", storage.ErrInvalidCursor, *cursor)
+		}
+
+		q.after = after
+	}
+
+	return q, nil
+}
+
+// where adds a condition results must match.
+func (q *listQuery) where(cond string, args ...interface{}) {
+	q.conds = append(q.conds, cond)
+	q.args = append(q.args, args...)
+}
+
+// whereHasPrefix adds the condition that a column starts with a prefix.
+func (q *listQuery) whereHasPrefix(column, prefix string) {
+	q.where(column+" LIKE ?", likeEscaper.Replace(prefix)+"%")
+}
+
+// sql returns the query and its arguments. It asks for one more result
+// than fits in the page, which tells whether there's a next page.
+func (q *listQuery) sql() (string, []interface{}) {
+	conds := append([]string{}, q.conds...)
+	args := append(append([]interface{}{}, q.withArgs...), q.args...)
+
+	cmp, dir := ">", "ASC"
+	if q.desc {
+		cmp, dir = "<", "DESC"
+	}
+
+	if q.after != nil {
+		conds = append(conds, fmt.Sprintf("(%s, %s) %s (?::%s, ?::%s)",
+			q.column.name, q.key.name, cmp, q.column.typ, q.key.typ,
+		))
+		args = append(args, q.after.Value, q.after.Key)
+	}
+
+	var b strings.Builder
+
+	if q.with != "" {
+		b.WriteString(q.with + "\n")
+	}
+
+	b.WriteString("SELECT " + q.columns + " FROM " + q.from)
+
+	if len(conds) > 0 {
+		b.WriteString(" WHERE " + strings.Join(conds, " AND "))
+	}
+
+	fmt.Fprintf(&b, " ORDER BY %s %s, %s %s LIMIT ?", q.column.name, dir, q.key.name, dir)
+	args = append(args, q.limit+1)
+
+	return numberPlaceholders(b.String(), len(q.withArgs)+1), args
+}
+
+// more trims the results of the query to the page and tells whether
+// there's a next page.
+func (q *listQuery) more(n int) (int, bool) {
+	if n > q.limit {
+		return q.limit, true
+	}
+
+	return n, false
+}
+
+// cursor returns the cursor of the page after the one whose last result
+// has the given sort column and key values.
+func (q *listQuery) cursor(value, key string) *string {
+	cursor := encodePageCursor(&pageCursor{
+		Sort:  q.sort,
+		Desc:  q.desc,
+		Value: value,
+		Key:   key,
+	})
+
+	return &cursor
+}
+
+// numberPlaceholders replaces the ? placeholders in a query with numbered
+// ones, starting at $first.
+func numberPlaceholders(query string, first int) string {
+	var b strings.Builder
+
+	n := first
+
+	for _, r := range query {
+		if r != '?' {
+			b.WriteRune(r)
+			continue
+		}
+
+		b.WriteString("$" + strconv.Itoa(n))
+		n++
+	}
+
+	return b.String()
+}
+
+// timeSortValue encodes a time column value for a cursor.
+func timeSortValue(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+func encodePageCursor(cursor *pageCursor) string {
+	// a struct of strings and a bool always marshals
+	data, _ := json.Marshal(cursor)
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodePageCursor(cursor string) (*pageCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", storage.ErrInvalidCursor, cursor)
+	}
+
+	var c pageCursor
+	if err := json.Unmarshal(data, &c); err != nil || c.Sort == "" {
+		return nil, fmt.Errorf("%w: %s", storage.ErrInvalidCursor, cursor)
+	}
+
+	return &c, nil
+}
@@ -0,0 +1,92 @@
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+	"github.com/infratographer/lmi/internal/storage"
+)
+
+func TestListQuery(t *testing.T) {
+	t.Parallel()
+
+	q, err := newListQuery("name", roleSortColumns, roleKeyColumn, nil, nil, nil)
+	require.NoError(t, err)
+
+	q.from = "roles"
+	q.with = "WITH RECURSIVE " + ancestorsCTE
+	q.withArgs = []interface{}{"scope"}
+	q.where("(directory_id IS NULL OR directory_id IN (SELECT id FROM ancestors))")
+	q.whereHasPrefix("name", "100%_sure")
+
+	query, args := q.sql()
+	assert.Contains(t, query, "SELECT $1::UUID")
+	assert.Contains(t, query, "SELECT * FROM roles WHERE (directory_id IS NULL OR directory_id IN (SELECT id FROM ancestors))"+
+		" AND name LIKE $2 ORDER BY name ASC, id ASC LIMIT $3")
+	assert.Equal(t, []interface{}{"scope", `100\%\_sure%`, defaultPageLimit + 1}, args)
+
+	n, more := q.more(defaultPageLimit)
+	assert.Equal(t, defaultPageLimit, n)
+	assert.False(t, more)
+
+	n, more = q.more(defaultPageLimit + 1)
+	assert.Equal(t, defaultPageLimit, n)
+	assert.True(t, more)
+
+	t.Run("next page", func(t *testing.T) {
+		t.Parallel()
+
+		order := apiv1.Desc
+		limit := 10
+
+		first, err := newListQuery("createdAt", roleSortColumns, roleKeyColumn, &order, &limit, nil)
+		require.NoError(t, err)
+
+		cursor := first.cursor("2023-03-06T10:15:20Z", "role-id")
+
+		next, err := newListQuery("createdAt", roleSortColumns, roleKeyColumn, &order, &limit, cursor)
+		require.NoError(t, err)
+
+		next.from = "roles"
+		next.where("name = ?", "admin")
+
+		query, args := next.sql()
+		assert.Equal(t, "SELECT * FROM roles WHERE name = $1 AND (created_at, id) < ($2::TIMESTAMP, $3::UUID)"+
+			" ORDER BY created_at DESC, id DESC LIMIT $4", query)
+		assert.Equal(t, []interface{}{"admin", "2023-03-06T10:15:20Z", "role-id", limit + 1}, args)
+	})
+
+	t.Run("limits", func(t *testing.T) {
+		t.Parallel()
+
+		limit := maxPageLimit + 1
+
+		q, err := newListQuery("name", roleSortColumns, roleKeyColumn, nil, &limit, nil)
+		require.NoError(t, err)
+		assert.Equal(t, maxPageLimit, q.limit)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newListQuery("description", roleSortColumns, roleKeyColumn, nil, nil, nil)
+		assert.ErrorIs(t, err, storage.ErrInvalidSort)
+
+		order := apiv1.SortOrder("sideways")
+		_, err = newListQuery("name", roleSortColumns, roleKeyColumn, &order, nil, nil)
+		assert.ErrorIs(t, err, storage.ErrInvalidSort)
+
+		garbage := "not a cursor"
+		_, err = newListQuery("name", roleSortColumns, roleKeyColumn, nil, nil, &garbage)
+		assert.ErrorIs(t, err, storage.ErrInvalidCursor)
+
+		byName, err := newListQuery("name", roleSortColumns, roleKeyColumn, nil, nil, nil)
+		require.NoError(t, err)
+
+		_, err = newListQuery("createdAt", roleSortColumns, roleKeyColumn, nil, nil, byName.cursor("admin", "role-id"))
+		assert.ErrorIs(t, err, storage.ErrInvalidCursor, "cursors are only valid for their sort")
+	})
+}
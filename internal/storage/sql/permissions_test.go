@@ -0,0 +1,167 @@
+package sql_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+	"github.com/infratographer/lmi/internal/storage"
+)
+
+func TestPermissions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	env := newTestEnv(t)
+
+	description := func(s string) *string { return &s }
+
+	// targets returns the targets of the registered permissions with a
+	// given prefix.
+	targets := func(t *testing.T, prefix string) []string {
+		t.Helper()
+
+		perms, err := env.store.GetPermissions(ctx, &apiv1.GetPermissionsParams{Prefix: &prefix})
+		require.NoError(t, err)
+
+		targets := []string{}
+		for _, p := range perms.Items {
+			targets = append(targets, p.Target)
+		}
+
+		return targets
+	}
+
+	t.Run("permissions are created once", func(t *testing.T) {
+		perm, err := env.store.CreatePermission(ctx, apiv1.Permission{
+			Target:      "instances.create",
+			Description: description("create instances"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "instances.create", perm.Target)
+		assert.Equal(t, description("create instances"), perm.Description)
+
+		_, err = env.store.CreatePermission(ctx, apiv1.Permission{Target: "instances.create"})
+		assert.ErrorIs(t, err, storage.ErrAlreadyExists)
+
+		assert.Equal(t, []string{"instances.create"}, targets(t, "instances."))
+	})
+
+	t.Run("permissions are updated", func(t *testing.T) {
+		perm, err := env.store.UpdatePermission(ctx, &apiv1.Permission{
+			Target:      "instances.create",
+			Description: description("launch instances"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, description("launch instances"), perm.Description)
+
+		target := "instances.create"
+		perms, err := env.store.GetPermissions(ctx, &apiv1.GetPermissionsParams{Target: &target})
+		require.NoError(t, err)
+		require.Len(t, perms.Items, 1)
+		assert.Equal(t, description("launch instances"), perms.Items[0].Description)
+
+		_, err = env.store.UpdatePermission(ctx, &apiv1.Permission{Target: "instances.unknown"})
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("permissions granted by roles aren't deleted", func(t *testing.T) {
+		env.createRole(ctx, t, apiv1.NewRole{Name: "instance-creator"}, "instances.create")
+
+		err := env.store.DeletePermission(ctx, "instances.create")
+		assert.ErrorIs(t, err, storage.ErrPermissionInUse)
+		assert.Equal(t, []string{"instances.create"}, targets(t, "instances."))
+	})
+
+	t.Run("unused permissions are deleted", func(t *testing.T) {
+		_, err := env.store.CreatePermission(ctx, apiv1.Permission{Target: "instances.delete"})
+		require.NoError(t, err)
+
+		require.NoError(t, env.store.DeletePermission(ctx, "instances.delete"))
+		assert.Equal(t, []string{"instances.create"}, targets(t, "instances."))
+
+		err = env.store.DeletePermission(ctx, "instances.delete")
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+
+	t.Run("service manifests add, update and retire permissions", func(t *testing.T) {
+		diff, err := env.store.RegisterServicePermissions(ctx, "volumes", apiv1.ServicePermissionManifest{
+			Permissions: []apiv1.Permission{
+				{Target: "create", Description: description("create volumes")},
+				{Target: "attach"},
+				{Target: "delete"},
+			},
+		})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"volumes.create", "volumes.attach", "volumes.delete"}, diff.Added)
+
+		env.createRole(ctx, t, apiv1.NewRole{Name: "volume-attacher"}, "volumes.attach")
+
+		diff, err = env.store.RegisterServicePermissions(ctx, "volumes", apiv1.ServicePermissionManifest{
+			Permissions: []apiv1.Permission{
+				{Target: "create", Description: description("provision volumes")},
+			},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, diff.Added)
+		assert.Equal(t, []string{"volumes.create"}, diff.Updated)
+		assert.Equal(t, []string{"volumes.delete"}, diff.Retired)
+		assert.Equal(t, []string{"volumes.attach"}, diff.Retained)
+
+		assert.ElementsMatch(t, []string{"volumes.create", "volumes.attach"}, targets(t, "volumes."))
+		assert.Equal(t, []string{"instances.create"}, targets(t, "instances."), "other services are left alone")
+	})
+
+	t.Run("targets granted through wildcards are revoked when they're removed", func(t *testing.T) {
+		scope := env.addDirectory(ctx, t, nil)
+		subject := env.trackSubject(ctx, t)
+
+		roleID := env.createRole(ctx, t, apiv1.NewRole{Name: "disk-admin"}, "disks.*")
+		env.assignRole(ctx, t, roleID, subject, scope)
+
+		_, err := env.store.RegisterServicePermissions(ctx, "disks", apiv1.ServicePermissionManifest{
+			Permissions: []apiv1.Permission{{Target: "create"}, {Target: "resize"}},
+		})
+		require.NoError(t, err)
+
+		assert.True(t, env.check(ctx, t, subject, "disks.create", scope).Allowed)
+		assert.True(t, env.check(ctx, t, subject, "disks.resize", scope).Allowed)
+
+		revoked := func(t *testing.T, seen int) []apiv1.EffectivePermission {
+			t.Helper()
+
+			perms := []apiv1.EffectivePermission{}
+
+			for _, evt := range env.events(ctx, t)[seen:] {
+				if evt.Type == apiv1.EventEffectivePermissionsChanged && evt.Changes.Subject == subject {
+					perms = append(perms, evt.Changes.Revoked...)
+				}
+			}
+
+			return perms
+		}
+
+		want := func(target string) []apiv1.EffectivePermission {
+			return []apiv1.EffectivePermission{{Target: target, Scope: scope.String(), Role: roleID.String()}}
+		}
+
+		seen := len(env.events(ctx, t))
+
+		require.NoError(t, env.store.DeletePermission(ctx, "disks.create"))
+		assert.Equal(t, want("disks.create"), revoked(t, seen))
+
+		seen = len(env.events(ctx, t))
+
+		diff, err := env.store.RegisterServicePermissions(ctx, "disks", apiv1.ServicePermissionManifest{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"disks.resize"}, diff.Retired)
+		assert.Equal(t, want("disks.resize"), revoked(t, seen))
+
+		for _, target := range []string{"disks.create", "disks.resize"} {
+			assert.False(t, env.check(ctx, t, subject, target, scope).Allowed, target)
+		}
+	})
+}
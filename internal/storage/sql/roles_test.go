@@ -0,0 +1,132 @@
+package sql_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+	"github.com/infratographer/lmi/internal/storage"
+	sqlstore "github.com/infratographer/lmi/internal/storage/sql"
+)
+
+func TestRoleDirectories(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	env := newTestEnv(t, sqlstore.WithAutoTrackSubjects(true))
+
+	root := env.addDirectory(ctx, t, nil)
+	child := env.addDirectory(ctx, t, &root)
+	grandchild := env.addDirectory(ctx, t, &child)
+	sibling := env.addDirectory(ctx, t, &root)
+
+	rootDir, childDir := root.String(), child.String()
+
+	globalRole := env.createRole(ctx, t, apiv1.NewRole{Name: "viewer"}, testTarget)
+	rootRole := env.createRole(ctx, t, apiv1.NewRole{Name: "root-admin", Directory: &rootDir}, testTarget)
+	childRole := env.createRole(ctx, t, apiv1.NewRole{Name: "child-admin", Directory: &childDir}, testTarget)
+
+	visible := func(t *testing.T, scope string) []apiv1.EntityID {
+		t.Helper()
+
+		roles, err := env.store.GetRoles(ctx, &apiv1.GetRolesParams{Scope: &scope})
+		require.NoError(t, err)
+
+		ids := []apiv1.EntityID{}
+		for _, r := range roles.Items {
+			ids = append(ids, r.Id)
+		}
+
+		return ids
+	}
+
+	t.Run("roles are visible in their directory's subtree", func(t *testing.T) {
+		assert.ElementsMatch(t, []apiv1.EntityID{globalRole, rootRole}, visible(t, root.String()))
+		assert.ElementsMatch(t, []apiv1.EntityID{globalRole, rootRole, childRole}, visible(t, child.String()))
+		assert.ElementsMatch(t, []apiv1.EntityID{globalRole, rootRole, childRole}, visible(t, grandchild.String()))
+		assert.ElementsMatch(t, []apiv1.EntityID{globalRole, rootRole}, visible(t, sibling.String()))
+	})
+
+	t.Run("roles are assignable below the directory they're defined in", func(t *testing.T) {
+		subject := newSubject()
+		env.assignRole(ctx, t, rootRole, subject, grandchild)
+
+		res := env.check(ctx, t, subject, testTarget, grandchild)
+		assert.True(t, res.Allowed)
+		assert.Equal(t, &rootRole, res.Role)
+
+		assert.False(t, env.check(ctx, t, subject, testTarget, child).Allowed)
+	})
+
+	t.Run("roles aren't assignable outside of their directory's subtree", func(t *testing.T) {
+		subject := newSubject()
+
+		err := env.store.AssignRole(ctx, childRole, apiv1.NewRoleAssignment{
+			Subject: subject,
+			Scope:   sibling.String(),
+		})
+		assert.ErrorIs(t, err, storage.ErrRoleNotInScope)
+
+		err = env.store.AssignRole(ctx, childRole, apiv1.NewRoleAssignment{
+			Subject: subject,
+			Scope:   root.String(),
+		})
+		assert.ErrorIs(t, err, storage.ErrRoleNotInScope)
+	})
+
+	t.Run("role names are unique per directory", func(t *testing.T) {
+		_, err := env.store.CreateRole(ctx, apiv1.NewRole{Name: "viewer"})
+		assert.ErrorIs(t, err, storage.ErrAlreadyExists)
+
+		_, err = env.store.CreateRole(ctx, apiv1.NewRole{Name: "child-admin", Directory: &childDir})
+		assert.ErrorIs(t, err, storage.ErrAlreadyExists)
+
+		// the same names are free in other directories
+		_, err = env.store.CreateRole(ctx, apiv1.NewRole{Name: "viewer", Directory: &rootDir})
+		assert.NoError(t, err)
+
+		_, err = env.store.CreateRole(ctx, apiv1.NewRole{Name: "child-admin", Directory: &rootDir})
+		assert.NoError(t, err)
+
+		_, err = env.store.CreateRole(ctx, apiv1.NewRole{Name: "child-admin"})
+		assert.NoError(t, err)
+	})
+}
+
+func TestDeleteRole(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	env := newTestEnv(t, sqlstore.WithAutoTrackSubjects(true))
+
+	root := env.addDirectory(ctx, t, nil)
+	child := env.addDirectory(ctx, t, &root)
+
+	roleID := env.createRole(ctx, t, apiv1.NewRole{Name: "instance-creator"}, testTarget)
+
+	subjects := []string{newSubject(), newSubject()}
+	env.assignRole(ctx, t, roleID, subjects[0], root)
+	env.assignRole(ctx, t, roleID, subjects[1], child)
+
+	seen := len(env.events(ctx, t))
+
+	require.NoError(t, env.store.DeleteRole(ctx, roleID, nil))
+
+	removed := []string{}
+
+	for _, evt := range env.events(ctx, t)[seen:] {
+		if evt.Type == apiv1.EventAssignmentRemoved {
+			assert.Equal(t, roleID, evt.Assignment.Role)
+			removed = append(removed, evt.Assignment.Subject)
+		}
+	}
+
+	assert.ElementsMatch(t, subjects, removed, "cascaded assignments are recorded")
+
+	for _, subject := range subjects {
+		assert.False(t, env.check(ctx, t, subject, testTarget, child).Allowed, subject)
+	}
+}
@@ -0,0 +1,301 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+	"github.com/infratographer/lmi/internal/storage"
+	"github.com/infratographer/lmi/internal/storage/sql/models"
+)
+
+func (drv *sqlDriver) GetSubjects(c context.Context) ([]*apiv1.Subject, error) {
+	ss, err := models.TrackedSubjects().All(c, drv.db)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get subjects: %w", err)
+	}
+
+	subjects := make([]*apiv1.Subject, len(ss))
+	for i, s := range ss {
+		subjects[i] = &apiv1.Subject{
+			Id: s.SubjectID,
+		}
+	}
+
+	return subjects, nil
+}
+
+func (drv *sqlDriver) CreateSubject(c context.Context, subject apiv1.Subject) (*apiv1.Subject, error) {
+	err := drv.executeTx(c, func(tx *txn) error {
+		exists, err := models.TrackedSubjectExists(c, tx, subject.Id)
+		if err != nil {
+			return fmt.Errorf("couldn't check if subject exists: %w", err)
+		}
+
+		if exists {
+			return fmt.Errorf("subject %s: %w", subject.Id, storage.ErrAlreadyExists)
+		}
+
+		s := &models.TrackedSubject{
+			SubjectID: subject.Id,
+		}
+
+		if err := s.Insert(c, tx, boil.Infer()); err != nil {
+			return fmt.Errorf("couldn't create subject: %w", err)
+		}
+
+		recordSubject(tx, apiv1.EventSubjectCreated, s.SubjectID)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.Subject{
+		Id: subject.Id,
+	}, nil
+}
+
+func (drv *sqlDriver) GetSubject(c context.Context, id string) (*apiv1.Subject, error) {
+	s, err := models.FindTrackedSubject(c, drv.db, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, fmt.Errorf("couldn't find subject: %w", err)
+	}
+
+	return &apiv1.Subject{
+		Id: s.SubjectID,
+	}, nil
+}
+
+func (drv *sqlDriver) DeleteSubject(c context.Context, id string, dryRun bool) (*apiv1.SubjectDeletion, error) {
+	var out *apiv1.SubjectDeletion
+
+	err := drv.executeTx(c, func(tx *txn) error {
+		s, err := models.FindTrackedSubject(c, tx, id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return storage.ErrNotFound
+			}
+			return fmt.Errorf("couldn't find subject: %w", err)
+		}
+
+		ras, err := s.SubjectRoleAssignments().All(c, tx)
+		if err != nil {
+			return fmt.Errorf("couldn't get subject role assignments: %w", err)
+		}
+
+		assignments := make([]apiv1.Assignment, len(ras))
+		for i, ra := range ras {
+			roleID, err := apiv1.ParseEntityID(ra.RoleID)
+			if err != nil {
+				return fmt.Errorf("couldn't parse role ID for role assignment %s", ra.ID)
+			}
+
+			assignments[i] = apiv1.Assignment{
+				Role:    roleID,
+				Subject: ra.SubjectID,
+				Scope:   ra.Scope,
+			}
+		}
+
+		denyRules, drs, err := subjectDenyRules(c, tx, s)
+		if err != nil {
+			return err
+		}
+
+		memberships, err := models.SubjectGroups(
+			qm.InnerJoin("group_members gm ON gm.group_id = subject_groups.subject_id"),
+			qm.Where("gm.member_id = ?", s.SubjectID),
+			qm.OrderBy(models.SubjectGroupColumns.Name),
+		).All(c, tx)
+		if err != nil {
+			return fmt.Errorf("couldn't get groups of subject: %w", err)
+		}
+
+		groups := make([]apiv1.Group, len(memberships))
+		for i, sg := range memberships {
+			groups[i] = *group(sg)
+		}
+
+		owned, members, err := subjectGroup(c, tx, s)
+		if err != nil {
+			return err
+		}
+
+		eps, err := s.SubjectEffectivePermissions().Count(c, tx)
+		if err != nil {
+			return fmt.Errorf("couldn't count subject effective permissions: %w", err)
+		}
+
+		out = &apiv1.SubjectDeletion{
+			Subject:              s.SubjectID,
+			Assignments:          assignments,
+			DenyRules:            denyRules,
+			Memberships:          groups,
+			Group:                owned,
+			Members:              members,
+			EffectivePermissions: eps,
+		}
+
+		if dryRun {
+			return nil
+		}
+
+		// Members of a group subject lose what they got through it.
+		impact, err := groupImpactOf(c, tx, s.SubjectID)
+		if err != nil {
+			return err
+		}
+
+		// The cascade would remove the subject's effective permissions
+		// too, but without recording the change.
+		if err := clearEffectivePermissions(c, tx, deleteSubjectEffectivePermissionsQuery, s.SubjectID); err != nil {
+			return err
+		}
+
+		// Role assignments, deny rules, memberships and the group the
+		// subject may be are removed by the cascade, so their removal
+		// is recorded here.
+		if _, err := s.Delete(c, tx); err != nil {
+			return fmt.Errorf("couldn't delete subject: %w", err)
+		}
+
+		for _, ra := range ras {
+			if err := recordAssignment(tx, apiv1.EventAssignmentRemoved, ra); err != nil {
+				return err
+			}
+		}
+
+		for _, rule := range drs {
+			rule := rule
+
+			tx.record(apiv1.EventDenyRuleDeleted, func(evt *apiv1.Event) {
+				evt.DenyRule = rule
+			})
+		}
+
+		for i := range groups {
+			recordGroupMember(tx, apiv1.EventGroupMemberRemoved, &groups[i], apiv1.Subject{Id: s.SubjectID})
+		}
+
+		if owned != nil {
+			for _, member := range members {
+				recordGroupMember(tx, apiv1.EventGroupMemberRemoved, owned, member)
+			}
+
+			tx.record(apiv1.EventGroupDeleted, func(evt *apiv1.Event) {
+				evt.Group = owned
+			})
+		}
+
+		recordSubject(tx, apiv1.EventSubjectDeleted, s.SubjectID)
+
+		out.Deleted = true
+
+		return impact.refresh(c, tx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// subjectDenyRules returns the deny rules of a subject, both as they're
+// previewed and as they're recorded once removed.
+func subjectDenyRules(
+	c context.Context,
+	exec boil.ContextExecutor,
+	s *models.TrackedSubject,
+) ([]apiv1.DenyRule, []*apiv1.DenyRule, error) {
+	drs, err := s.SubjectDenyRules(
+		qm.OrderBy(models.DenyRuleColumns.CreatedAt+", "+models.DenyRuleColumns.ID),
+	).All(c, exec)
+	if err != nil {
+		return nil, nil, fmt.Errorf("couldn't get subject deny rules: %w", err)
+	}
+
+	preview := make([]apiv1.DenyRule, len(drs))
+	rules := make([]*apiv1.DenyRule, len(drs))
+
+	for i, dr := range drs {
+		rules[i], err = denyRule(dr)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		preview[i] = *rules[i]
+	}
+
+	return preview, rules, nil
+}
+
+// subjectGroup returns the group a subject is, along with its members, or
+// nil if the subject isn't a group.
+func subjectGroup(
+	c context.Context,
+	exec boil.ContextExecutor,
+	s *models.TrackedSubject,
+) (*apiv1.Group, []apiv1.Subject, error) {
+	members := []apiv1.Subject{}
+
+	sg, err := s.SubjectSubjectGroup().One(c, exec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, members, nil
+		}
+		return nil, nil, fmt.Errorf("couldn't get group of subject: %w", err)
+	}
+
+	gms, err := sg.GroupGroupMembers(
+		qm.OrderBy(models.GroupMemberColumns.MemberID),
+	).All(c, exec)
+	if err != nil {
+		return nil, nil, fmt.Errorf("couldn't get members of group %s: %w", sg.SubjectID, err)
+	}
+
+	for _, gm := range gms {
+		members = append(members, apiv1.Subject{Id: gm.MemberID})
+	}
+
+	return group(sg), members, nil
+}
+
+// ensureSubject verifies that a subject is tracked before it's referenced.
+// Unknown subjects are tracked on the spot if the driver is configured to
+// do so.
+func (drv *sqlDriver) ensureSubject(c context.Context, tx *txn, id string) error {
+	exists, err := models.TrackedSubjectExists(c, tx, id)
+	if err != nil {
+		return fmt.Errorf("couldn't check if subject exists: %w", err)
+	}
+
+	if exists {
+		return nil
+	}
+
+	if !drv.autoTrackSubjects {
+		return fmt.Errorf("subject %s: %w", id, storage.ErrSubjectNotTracked)
+	}
+
+	s := &models.TrackedSubject{
+		SubjectID: id,
+	}
+
+	if err := s.Insert(c, tx, boil.Infer()); err != nil {
+		return fmt.Errorf("couldn't track subject: %w", err)
+	}
+
+	recordSubject(tx, apiv1.EventSubjectCreated, id)
+
+	return nil
+}
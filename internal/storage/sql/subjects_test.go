@@ -0,0 +1,98 @@
+package sql_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+	"github.com/infratographer/lmi/internal/storage"
+	sqlstore "github.com/infratographer/lmi/internal/storage/sql"
+)
+
+func TestDeleteSubject(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	env := newTestEnv(t, sqlstore.WithAutoTrackSubjects(true))
+
+	root := env.addDirectory(ctx, t, nil)
+
+	engineering, err := env.store.CreateGroup(ctx, apiv1.NewGroup{Name: "engineering"})
+	require.NoError(t, err)
+
+	ops, err := env.store.CreateGroup(ctx, apiv1.NewGroup{Name: "ops"})
+	require.NoError(t, err)
+
+	member := env.trackSubject(ctx, t)
+
+	require.NoError(t, env.store.AddGroupMember(ctx, engineering.Id, apiv1.Subject{Id: ops.Id}))
+	require.NoError(t, env.store.AddGroupMember(ctx, ops.Id, apiv1.Subject{Id: member}))
+
+	roleID := env.createRole(ctx, t, apiv1.NewRole{Name: "instance-creator"}, testTarget)
+	env.assignRole(ctx, t, roleID, ops.Id, root)
+
+	denied := "instances.delete"
+
+	dr, err := env.store.CreateDenyRule(ctx, apiv1.NewDenyRule{
+		Subject: &ops.Id,
+		Scope:   root.String(),
+		Target:  denied,
+	})
+	require.NoError(t, err)
+
+	t.Run("dry runs preview everything removed with the subject", func(t *testing.T) {
+		seen := len(env.events(ctx, t))
+
+		res, err := env.store.DeleteSubject(ctx, ops.Id, true)
+		require.NoError(t, err)
+
+		assert.False(t, res.Deleted)
+		assert.Equal(t, ops.Id, res.Subject)
+		require.Len(t, res.Assignments, 1)
+		assert.Equal(t, roleID, res.Assignments[0].Role)
+		require.Len(t, res.DenyRules, 1)
+		assert.Equal(t, dr.Id, res.DenyRules[0].Id)
+		require.Len(t, res.Memberships, 1)
+		assert.Equal(t, engineering.Id, res.Memberships[0].Id)
+		require.NotNil(t, res.Group)
+		assert.Equal(t, "ops", res.Group.Name)
+		assert.Equal(t, []apiv1.Subject{{Id: member}}, res.Members)
+		assert.Positive(t, res.EffectivePermissions)
+
+		assert.Len(t, env.events(ctx, t), seen, "dry runs don't record events")
+
+		_, err = env.store.GetGroup(ctx, ops.Id)
+		assert.NoError(t, err)
+	})
+
+	t.Run("deletes record an event for everything removed", func(t *testing.T) {
+		seen := len(env.events(ctx, t))
+
+		res, err := env.store.DeleteSubject(ctx, ops.Id, false)
+		require.NoError(t, err)
+		assert.True(t, res.Deleted)
+
+		removed := map[apiv1.EventType]int{}
+		for _, evt := range env.events(ctx, t)[seen:] {
+			removed[evt.Type]++
+		}
+
+		assert.Equal(t, 1, removed[apiv1.EventAssignmentRemoved])
+		assert.Equal(t, 1, removed[apiv1.EventDenyRuleDeleted])
+		assert.Equal(t, 2, removed[apiv1.EventGroupMemberRemoved], "membership and member")
+		assert.Equal(t, 1, removed[apiv1.EventGroupDeleted])
+		assert.Equal(t, 1, removed[apiv1.EventSubjectDeleted])
+
+		_, err = env.store.GetGroup(ctx, ops.Id)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+
+		members, err := env.store.GetGroupMembers(ctx, engineering.Id)
+		require.NoError(t, err)
+		assert.Empty(t, members)
+
+		assert.False(t, env.check(ctx, t, member, testTarget, root).Allowed)
+	})
+}
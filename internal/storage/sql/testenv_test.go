@@ -0,0 +1,169 @@
+package sql_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach-go/v2/testserver"
+	"github.com/google/uuid"
+	fsv1 "github.com/infratographer/fertilesoil/api/v1"
+	appv1 "github.com/infratographer/fertilesoil/app/v1"
+	appv1sql "github.com/infratographer/fertilesoil/app/v1/sql"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	apiv1 "github.com/infratographer/lmi/api/v1"
+	"github.com/infratographer/lmi/internal/storage"
+	sqlstore "github.com/infratographer/lmi/internal/storage/sql"
+	"github.com/infratographer/lmi/internal/storage/sql/migrations"
+	"github.com/infratographer/lmi/internal/storage/sql/models"
+)
+
+const testTarget = "instances.create"
+
+type testEnv struct {
+	db       *sql.DB
+	appStore appv1.AppStorage
+	store    storage.Storage
+}
+
+func newTestEnv(t *testing.T, opts ...sqlstore.Option) *testEnv {
+	t.Helper()
+
+	ts, err := testserver.NewTestServer()
+	require.NoError(t, err)
+	t.Cleanup(ts.Stop)
+
+	db, err := sql.Open("postgres", ts.PGURL().String())
+	require.NoError(t, err, "failed to open db connection")
+
+	require.NoError(t, migrations.Migrate(db), "failed to run migrations")
+
+	return &testEnv{
+		db:       db,
+		appStore: appv1sql.New(db),
+		store:    sqlstore.NewSQLDriver(db, opts...),
+	}
+}
+
+// addDirectory persists a directory the way the fertilesoil controller
+// does and tracks it the way the reconciler does.
+func (env *testEnv) addDirectory(ctx context.Context, t *testing.T, parent *fsv1.DirectoryID) fsv1.DirectoryID {
+	t.Helper()
+
+	d := &fsv1.Directory{
+		Id:        fsv1.DirectoryID(uuid.New()),
+		Name:      "dir",
+		Parent:    parent,
+		CreatedAt: time.Now().UTC(),
+		UpdatedAt: time.Now().UTC(),
+	}
+
+	_, err := env.appStore.CreateDirectory(ctx, d)
+	require.NoError(t, err)
+	require.NoError(t, env.store.AddDirectory(ctx, d.Id, parent))
+
+	return d.Id
+}
+
+// newSubject returns the ID of a subject that's not tracked yet.
+func newSubject() string {
+	return "urn:infratographer:user:" + uuid.NewString()
+}
+
+// trackSubject tracks a new subject and returns its ID.
+func (env *testEnv) trackSubject(ctx context.Context, t *testing.T) string {
+	t.Helper()
+
+	subject, err := env.store.CreateSubject(ctx, apiv1.Subject{Id: newSubject()})
+	require.NoError(t, err)
+
+	return subject.Id
+}
+
+// createRole creates a role granting the given targets, registering the
+// targets first if needed.
+func (env *testEnv) createRole(ctx context.Context, t *testing.T, newRole apiv1.NewRole, targets ...string) apiv1.EntityID {
+	t.Helper()
+
+	role, err := env.store.CreateRole(ctx, newRole)
+	require.NoError(t, err)
+
+	for _, target := range targets {
+		env.addRolePermission(ctx, t, role.Id, target)
+	}
+
+	return role.Id
+}
+
+// addRolePermission adds a permission to a role, registering its target
+// first if needed.
+func (env *testEnv) addRolePermission(ctx context.Context, t *testing.T, roleID apiv1.EntityID, target string) {
+	t.Helper()
+
+	perm := &models.Permission{Target: target, Description: target}
+	require.NoError(t, perm.Upsert(ctx, env.db, false, nil, boil.Infer(), boil.Infer()))
+
+	_, err := env.store.AddRolePermission(ctx, roleID, apiv1.PermissionIdentifier{Target: target}, nil)
+	require.NoError(t, err)
+}
+
+func (env *testEnv) assignRole(ctx context.Context, t *testing.T, roleID apiv1.EntityID, subject string, scope fsv1.DirectoryID) {
+	t.Helper()
+
+	require.NoError(t, env.store.AssignRole(ctx, roleID, apiv1.NewRoleAssignment{
+		Subject: subject,
+		Scope:   scope.String(),
+	}))
+}
+
+func (env *testEnv) check(
+	ctx context.Context,
+	t *testing.T,
+	subject, target string,
+	scope fsv1.DirectoryID,
+) *apiv1.CheckResult {
+	t.Helper()
+
+	res, err := env.store.CheckPermission(ctx, apiv1.CheckRequest{
+		Subject: subject,
+		Target:  target,
+		Scope:   scope.String(),
+	})
+	require.NoError(t, err)
+
+	return res
+}
+
+// events returns the events written to the outbox, oldest first.
+func (env *testEnv) events(ctx context.Context, t *testing.T) []*apiv1.Event {
+	t.Helper()
+
+	rows, err := models.EventOutboxes(qm.OrderBy(models.EventOutboxColumns.ID)).All(ctx, env.db)
+	require.NoError(t, err)
+
+	evts := make([]*apiv1.Event, len(rows))
+	for i, row := range rows {
+		evts[i] = &apiv1.Event{}
+		require.NoError(t, row.Payload.Unmarshal(evts[i]))
+	}
+
+	return evts
+}
+
+// changedSubjects returns the subjects whose effective permissions
+// changed in the given events.
+func changedSubjects(evts []*apiv1.Event) []string {
+	subjects := []string{}
+
+	for _, evt := range evts {
+		if evt.Type == apiv1.EventEffectivePermissionsChanged {
+			subjects = append(subjects, evt.Changes.Subject)
+		}
+	}
+
+	return subjects
+}
@@ -0,0 +1,63 @@
+package sweeper
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/infratographer/lmi/internal/storage"
+)
+
+// KeyPruner periodically removes the expired idempotency keys, along with
+// the responses stored for them.
+type KeyPruner struct {
+	store    storage.IdempotencyStorage
+	interval time.Duration
+	logger   *zap.Logger
+}
+
+func NewKeyPruner(
+	store storage.IdempotencyStorage,
+	interval time.Duration,
+	logger *zap.Logger,
+) *KeyPruner {
+	return &KeyPruner{
+		store:    store,
+		interval: interval,
+		logger:   logger.Named("key-pruner"),
+	}
+}
+
+// Run prunes right away and then on every interval, until the context is
+// canceled. Failed prunes are logged and retried on the next interval.
+func (p *KeyPruner) Run(ctx context.Context) error {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := p.Prune(ctx); err != nil {
+			p.logger.Error("failed to prune idempotency keys", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Prune removes the idempotency keys that expired.
+func (p *KeyPruner) Prune(ctx context.Context) error {
+	pruned, err := p.store.PruneIdempotencyKeys(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if pruned > 0 {
+		p.logger.Info("pruned idempotency keys", zap.Int64("keys", pruned))
+	}
+
+	return nil
+}
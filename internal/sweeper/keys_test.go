@@ -0,0 +1,65 @@
+package sweeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/infratographer/lmi/internal/storage"
+	"github.com/infratographer/lmi/internal/sweeper"
+)
+
+type fakeKeyStore struct {
+	storage.IdempotencyStorage
+
+	expired int64
+	before  []time.Time
+}
+
+func (s *fakeKeyStore) PruneIdempotencyKeys(_ context.Context, before time.Time) (int64, error) {
+	s.before = append(s.before, before)
+
+	pruned := s.expired
+	s.expired = 0
+
+	return pruned, nil
+}
+
+func TestPruneKeys(t *testing.T) {
+	t.Parallel()
+
+	store := &fakeKeyStore{expired: 3}
+	core, logs := observer.New(zap.InfoLevel)
+
+	p := sweeper.NewKeyPruner(store, time.Minute, zap.New(core))
+
+	require.NoError(t, p.Prune(context.Background()))
+
+	entries := logs.FilterMessage("pruned idempotency keys").All()
+	require.Len(t, entries, 1)
+	assert.Equal(t, int64(3), entries[0].ContextMap()["keys"])
+
+	require.NoError(t, p.Prune(context.Background()))
+	assert.Len(t, logs.FilterMessage("pruned idempotency keys").All(), 1, "nothing to prune")
+
+	require.Len(t, store.before, 2)
+	assert.WithinDuration(t, time.Now(), store.before[1], time.Minute, "prunes the keys that expired by now")
+}
+
+func TestKeyPrunerStopsWithContext(t *testing.T) {
+	t.Parallel()
+
+	store := &fakeKeyStore{}
+	p := sweeper.NewKeyPruner(store, time.Hour, zap.NewNop())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.ErrorIs(t, p.Run(ctx), context.Canceled)
+	assert.Len(t, store.before, 1, "prunes once right away")
+}
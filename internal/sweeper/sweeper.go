@@ -0,0 +1,87 @@
+package sweeper
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/infratographer/lmi/internal/storage"
+)
+
+// clockSkew makes every sweep look back a little further than the
+// previous one started, in case the database clock is behind ours.
+const clockSkew = 5 * time.Second
+
+// Sweeper periodically removes the effective permissions granted by
+// expired role assignments and applies the ones that became active. The
+// storage records an event for every subject whose access lapsed.
+type Sweeper struct {
+	store    storage.AssignmentExpiryStorage
+	interval time.Duration
+	logger   *zap.Logger
+}
+
+func NewSweeper(
+	store storage.AssignmentExpiryStorage,
+	interval time.Duration,
+	logger *zap.Logger,
+) *Sweeper {
+	return &Sweeper{
+		store:    store,
+		interval: interval,
+		logger:   logger.Named("sweeper"),
+	}
+}
+
+// Run sweeps right away and then on every interval, until the context
+// is canceled. Failed sweeps are logged and retried on the next interval.
+func (s *Sweeper) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	// The first sweep applies every assignment that became active
+	// while we weren't running.
+	var since time.Time
+
+	for {
+		start := time.Now()
+
+		if err := s.Sweep(ctx, since); err != nil {
+			s.logger.Error("failed to sweep role assignments", zap.Error(err))
+		} else {
+			since = start.Add(-clockSkew)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sweep removes the lapsed effective permissions and applies the role
+// assignments that became active after since.
+func (s *Sweeper) Sweep(ctx context.Context, since time.Time) error {
+	lapsed, err := s.store.SweepRoleAssignments(ctx, since)
+	if err != nil {
+		return err
+	}
+
+	if len(lapsed) == 0 {
+		return nil
+	}
+
+	subjects := map[string]struct{}{}
+	for _, lp := range lapsed {
+		subjects[lp.Subject] = struct{}{}
+	}
+
+	s.logger.Info("access lapsed",
+		zap.Int("subjects", len(subjects)),
+		zap.Int("permissions", len(lapsed)),
+	)
+
+	return nil
+}
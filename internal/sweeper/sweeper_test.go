@@ -0,0 +1,72 @@
+package sweeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/infratographer/lmi/internal/storage"
+	"github.com/infratographer/lmi/internal/sweeper"
+)
+
+type fakeStore struct {
+	lapsed []storage.LapsedPermission
+	since  []time.Time
+}
+
+func (s *fakeStore) SweepRoleAssignments(_ context.Context, since time.Time) ([]storage.LapsedPermission, error) {
+	s.since = append(s.since, since)
+
+	lapsed := s.lapsed
+	s.lapsed = nil
+
+	return lapsed, nil
+}
+
+func TestSweep(t *testing.T) {
+	t.Parallel()
+
+	store := &fakeStore{
+		lapsed: []storage.LapsedPermission{
+			{Subject: "urn:infratographer:user:a", Target: "instances.create", Scope: "s1", Role: "r1"},
+			{Subject: "urn:infratographer:user:b", Target: "instances.create", Scope: "s1", Role: "r1"},
+			{Subject: "urn:infratographer:user:a", Target: "instances.delete", Scope: "s1", Role: "r1"},
+		},
+	}
+	core, logs := observer.New(zap.InfoLevel)
+
+	s := sweeper.NewSweeper(store, time.Minute, zap.New(core))
+
+	require.NoError(t, s.Sweep(context.Background(), time.Time{}))
+
+	entries := logs.FilterMessage("access lapsed").All()
+	require.Len(t, entries, 1)
+
+	fields := entries[0].ContextMap()
+	assert.Equal(t, int64(2), fields["subjects"])
+	assert.Equal(t, int64(3), fields["permissions"])
+
+	t.Run("nothing lapsed", func(t *testing.T) {
+		require.NoError(t, s.Sweep(context.Background(), time.Now()))
+		assert.Len(t, logs.FilterMessage("access lapsed").All(), 1)
+	})
+}
+
+func TestRunStopsWithContext(t *testing.T) {
+	t.Parallel()
+
+	store := &fakeStore{}
+	s := sweeper.NewSweeper(store, time.Hour, zap.NewNop())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.ErrorIs(t, s.Run(ctx), context.Canceled)
+	require.Len(t, store.since, 1, "sweeps once right away")
+	assert.True(t, store.since[0].IsZero())
+}